@@ -18,11 +18,6 @@ resource "twilio_chat_service" "terraform_dev" {
 
 resource "twilio_chat_service" "terraform_test" {
   friendly_name = "terraform-test-1"
-  roles {
-    default_service_role = "RL69a3adbc16d9489093fe084f4c798e30"
-    default_channel_role = "RL52ecdd95b762485db2363ccff1b82a44"
-    default_channel_creator_role = "RL5c67ab91b0b94a2cb95e5c53a263f1d1"
-  }
   limits {
     user_channels = 250
     channel_members = 100
@@ -67,7 +62,75 @@ resource "twilio_chat_service" "terraform_test" {
   }
 }
 
-resource "twilio_chat_fcm_credential" "terraform_fcm_credential" {
+resource "twilio_chat_role" "terraform_test_moderator" {
+  service_sid = twilio_chat_service.terraform_test.id
+  friendly_name = "moderator"
+  type = "channel"
+  permissions = ["sendMessage", "sendMediaMessage", "removeMember", "deleteAnyMessage"]
+}
+
+resource "twilio_chat_channel" "terraform_test_announcements" {
+  service_sid = twilio_chat_service.terraform_test.id
+  friendly_name = "Announcements"
+  unique_name = "announcements"
+  attributes = jsonencode({
+    pinned = true
+  })
+}
+
+resource "twilio_chat_channel" "terraform_test_support" {
+  service_sid = twilio_chat_service.terraform_test.id
+  friendly_name = "Support"
+  unique_name = "support"
+}
+
+resource "twilio_chat_role" "terraform_test_bot" {
+  service_sid = twilio_chat_service.terraform_test.id
+  friendly_name = "bot"
+  type = "deployment"
+  permissions = ["joinChannel", "editOwnUserInfo"]
+}
+
+resource "twilio_chat_user" "terraform_test_support_bot" {
+  service_sid = twilio_chat_service.terraform_test.id
+  identity = "support-bot"
+  friendly_name = "Support Bot"
+  role_sid = twilio_chat_role.terraform_test_bot.id
+  attributes = jsonencode({
+    bot = true
+  })
+}
+
+resource "twilio_chat_channel_member" "terraform_test_support_bot" {
+  service_sid = twilio_chat_service.terraform_test.id
+  channel_sid = twilio_chat_channel.terraform_test_support.id
+  identity = twilio_chat_user.terraform_test_support_bot.identity
+  role_sid = twilio_chat_role.terraform_test_moderator.id
+}
+
+resource "twilio_chat_channel_webhook" "terraform_test_support" {
+  service_sid = twilio_chat_service.terraform_test.id
+  channel_sid = twilio_chat_channel.terraform_test_support.id
+  type = "webhook"
+
+  configuration {
+    url = "https://example.com/support/events"
+    filters = ["onMessageSent", "onMemberAdded"]
+  }
+}
+
+resource "twilio_chat_credential" "terraform_fcm_credential" {
   friendly_name = "terraform-test-fcm-credential"
-  secret = var.fcm_secret
+  type = "fcm"
+
+  fcm {
+    secret = var.fcm_secret
+  }
+}
+
+resource "twilio_chat_apn_credential" "terraform_apn_credential" {
+  friendly_name = "terraform-test-apn-credential"
+  certificate = file(var.apn_certificate_file)
+  private_key = var.apn_private_key
+  sandbox = true
 }
@@ -8,4 +8,11 @@ variable "auth_token" {
 
 variable "fcm_secret" {
   type = string
-}
\ No newline at end of file
+}
+variable "apn_certificate_file" {
+  type = string
+}
+
+variable "apn_private_key" {
+  type = string
+}
@@ -3,6 +3,7 @@ module terraform-provider-twilio
 go 1.16
 
 require (
-	github.com/hashicorp/terraform-plugin-sdk/v2 v2.7.0
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
+	github.com/hashicorp/terraform-plugin-sdk/v2 v2.10.1
 	github.com/twilio/twilio-go v0.12.0
 )
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	accounts "github.com/twilio/twilio-go/rest/api/v2010"
+)
+
+// accountsBaseURL is the host the relative page URIs of the 2010 API refer to.
+const accountsBaseURL = "https://api.twilio.com"
+
+// FindSubaccount returns the SID of the active subaccount named friendlyName.
+// The name has to identify exactly one subaccount of the authenticated account.
+func (c *Client) FindSubaccount(ctx context.Context, friendlyName string) (string, error) {
+	status := "active"
+	size := pageSize
+
+	res, err := c.RestClient(ctx).ApiV2010.ListAccount(&accounts.ListAccountParams{
+		FriendlyName: &friendlyName,
+		Status:       &status,
+		PageSize:     &size,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sids := []string{}
+	for {
+		for _, account := range res.Accounts {
+			if account.Sid == nil || *account.Sid == c.accountSid {
+				continue
+			}
+			sids = append(sids, *account.Sid)
+		}
+
+		if res.NextPageUri == "" {
+			break
+		}
+		next := res.NextPageUri
+		res = &accounts.ListAccountResponse{}
+		if err := c.nextPage(ctx, accountsBaseURL+next, res); err != nil {
+			return "", err
+		}
+	}
+
+	switch len(sids) {
+	case 0:
+		return "", fmt.Errorf("no active subaccount named %q", friendlyName)
+	case 1:
+		return sids[0], nil
+	}
+
+	return "", fmt.Errorf("%d subaccounts are named %q (%s), use subaccount_sid instead", len(sids), friendlyName, strings.Join(sids, ", "))
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const parentSid = "AC00000000000000000000000000000000"
+
+// newAccountsServer lists the given account SIDs one per page, like the
+// 2010 API with a page size of one.
+func newAccountsServer(t *testing.T, sids ...string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2010-04-01/Accounts.json" {
+			http.NotFound(w, r)
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("Page"))
+
+		res := map[string]interface{}{"accounts": []interface{}{}}
+		if page < len(sids) {
+			res["accounts"] = []interface{}{map[string]interface{}{"sid": sids[page]}}
+		}
+		if page+1 < len(sids) {
+			res["next_page_uri"] = fmt.Sprintf("/2010-04-01/Accounts.json?FriendlyName=%s&PageSize=1&Page=%d", r.URL.Query().Get("FriendlyName"), page+1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFindSubaccount(t *testing.T) {
+	tests := map[string]struct {
+		sids    []string
+		want    string
+		wantErr string
+	}{
+		"on a later page": {
+			sids: []string{parentSid, "AC11111111111111111111111111111111"},
+			want: "AC11111111111111111111111111111111",
+		},
+		"missing": {
+			sids:    []string{parentSid},
+			wantErr: `no active subaccount named "staging"`,
+		},
+		"ambiguous across pages": {
+			sids:    []string{"AC11111111111111111111111111111111", parentSid, "AC22222222222222222222222222222222"},
+			wantErr: `2 subaccounts are named "staging"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := newAccountsServer(t, tt.sids...)
+			client, err := NewClient(Config{
+				AccountSid: parentSid,
+				Username:   parentSid,
+				Password:   "test",
+				Endpoints:  map[string]string{"api": server.URL},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := client.FindSubaccount(context.Background(), "staging")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("FindSubaccount: got %q, %v, want an error containing %q", got, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("FindSubaccount = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
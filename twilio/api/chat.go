@@ -0,0 +1,181 @@
+package api
+
+import (
+	"context"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+// ServiceAPI manages Chat services.
+type ServiceAPI interface {
+	CreateService(params *openapi.CreateServiceParams) (*openapi.ChatV2Service, error)
+	FetchService(sid string) (*openapi.ChatV2Service, error)
+	UpdateService(sid string, params *openapi.UpdateServiceParams) (*openapi.ChatV2Service, error)
+	DeleteService(sid string) error
+	ListService(params *openapi.ListServiceParams) (*openapi.ListServiceResponse, error)
+}
+
+// CredentialAPI manages Chat push notification credentials.
+type CredentialAPI interface {
+	CreateCredential(params *openapi.CreateCredentialParams) (*openapi.ChatV2Credential, error)
+	FetchCredential(sid string) (*openapi.ChatV2Credential, error)
+	UpdateCredential(sid string, params *openapi.UpdateCredentialParams) (*openapi.ChatV2Credential, error)
+	DeleteCredential(sid string) error
+}
+
+// RoleAPI manages the roles of Chat services.
+type RoleAPI interface {
+	CreateRole(serviceSid string, params *openapi.CreateRoleParams) (*openapi.ChatV2ServiceRole, error)
+	FetchRole(serviceSid string, sid string) (*openapi.ChatV2ServiceRole, error)
+	UpdateRole(serviceSid string, sid string, params *openapi.UpdateRoleParams) (*openapi.ChatV2ServiceRole, error)
+	DeleteRole(serviceSid string, sid string) error
+	ListRole(serviceSid string, params *openapi.ListRoleParams) (*openapi.ListRoleResponse, error)
+}
+
+// ChannelAPI manages the channels of Chat services.
+type ChannelAPI interface {
+	CreateChannel(serviceSid string, params *openapi.CreateChannelParams) (*openapi.ChatV2ServiceChannel, error)
+	FetchChannel(serviceSid string, sid string) (*openapi.ChatV2ServiceChannel, error)
+	UpdateChannel(serviceSid string, sid string, params *openapi.UpdateChannelParams) (*openapi.ChatV2ServiceChannel, error)
+	DeleteChannel(serviceSid string, sid string, params *openapi.DeleteChannelParams) error
+}
+
+// UserAPI manages the users of Chat services.
+type UserAPI interface {
+	CreateUser(serviceSid string, params *openapi.CreateUserParams) (*openapi.ChatV2ServiceUser, error)
+	FetchUser(serviceSid string, sid string) (*openapi.ChatV2ServiceUser, error)
+	UpdateUser(serviceSid string, sid string, params *openapi.UpdateUserParams) (*openapi.ChatV2ServiceUser, error)
+	DeleteUser(serviceSid string, sid string) error
+}
+
+// MemberAPI manages the members of Chat channels.
+type MemberAPI interface {
+	CreateMember(serviceSid string, channelSid string, params *openapi.CreateMemberParams) (*openapi.ChatV2ServiceChannelMember, error)
+	FetchMember(serviceSid string, channelSid string, sid string) (*openapi.ChatV2ServiceChannelMember, error)
+	UpdateMember(serviceSid string, channelSid string, sid string, params *openapi.UpdateMemberParams) (*openapi.ChatV2ServiceChannelMember, error)
+	DeleteMember(serviceSid string, channelSid string, sid string, params *openapi.DeleteMemberParams) error
+}
+
+// ChannelWebhookAPI manages the webhooks of single Chat channels.
+type ChannelWebhookAPI interface {
+	CreateChannelWebhook(serviceSid string, channelSid string, params *openapi.CreateChannelWebhookParams) (*openapi.ChatV2ServiceChannelChannelWebhook, error)
+	FetchChannelWebhook(serviceSid string, channelSid string, sid string) (*openapi.ChatV2ServiceChannelChannelWebhook, error)
+	UpdateChannelWebhook(serviceSid string, channelSid string, sid string, params *openapi.UpdateChannelWebhookParams) (*openapi.ChatV2ServiceChannelChannelWebhook, error)
+	DeleteChannelWebhook(serviceSid string, channelSid string, sid string) error
+}
+
+// ChatAPI is the part of the Chat v2 API used by the resources. It is
+// implemented by the twilio-go Chat v2 client, see restChat.
+type ChatAPI interface {
+	ServiceAPI
+	CredentialAPI
+	RoleAPI
+	ChannelAPI
+	UserAPI
+	MemberAPI
+	ChannelWebhookAPI
+	PageAPI
+}
+
+// restChat is the ChatAPI of a twilio-go REST client.
+type restChat struct {
+	*openapi.ApiService
+	restPages
+}
+
+var _ ChatAPI = restChat{}
+
+// NewClientWithChat returns a Client that serves every Chat API call from
+// chat, so that resources can be tested with in-memory fakes.
+func NewClientWithChat(chat ChatAPI) *Client {
+	return &Client{
+		chat: func(ctx context.Context) ChatAPI {
+			return chat
+		},
+	}
+}
+
+// Services returns the Chat service API bound to ctx.
+func (c *Client) Services(ctx context.Context) ServiceAPI {
+	return c.chatAPI(ctx)
+}
+
+// Credentials returns the Chat credential API bound to ctx.
+func (c *Client) Credentials(ctx context.Context) CredentialAPI {
+	return c.chatAPI(ctx)
+}
+
+// Roles returns the Chat role API bound to ctx.
+func (c *Client) Roles(ctx context.Context) RoleAPI {
+	return c.chatAPI(ctx)
+}
+
+// Channels returns the Chat channel API bound to ctx.
+func (c *Client) Channels(ctx context.Context) ChannelAPI {
+	return c.chatAPI(ctx)
+}
+
+// Users returns the Chat user API bound to ctx.
+func (c *Client) Users(ctx context.Context) UserAPI {
+	return c.chatAPI(ctx)
+}
+
+// Members returns the Chat channel member API bound to ctx.
+func (c *Client) Members(ctx context.Context) MemberAPI {
+	return c.chatAPI(ctx)
+}
+
+// ChannelWebhooks returns the Chat channel webhook API bound to ctx.
+func (c *Client) ChannelWebhooks(ctx context.Context) ChannelWebhookAPI {
+	return c.chatAPI(ctx)
+}
+
+// ListServices returns every Chat service of the account, following the pages of the list.
+func (c *Client) ListServices(ctx context.Context) ([]openapi.ChatV2Service, error) {
+	chat := c.chatAPI(ctx)
+	size := pageSize
+	res, err := chat.ListService(&openapi.ListServiceParams{PageSize: &size})
+	if err != nil {
+		return nil, err
+	}
+
+	services := res.Services
+	for next := res.Meta.NextPageUrl; next != ""; next = res.Meta.NextPageUrl {
+		res = &openapi.ListServiceResponse{}
+		if err := chat.FetchPage(next, res); err != nil {
+			return nil, err
+		}
+		services = append(services, res.Services...)
+	}
+
+	return services, nil
+}
+
+// ListRoles returns every role of the service serviceSid, following the pages of the list.
+func (c *Client) ListRoles(ctx context.Context, serviceSid string) ([]openapi.ChatV2ServiceRole, error) {
+	chat := c.chatAPI(ctx)
+	size := pageSize
+	res, err := chat.ListRole(serviceSid, &openapi.ListRoleParams{PageSize: &size})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := res.Roles
+	for next := res.Meta.NextPageUrl; next != ""; next = res.Meta.NextPageUrl {
+		res = &openapi.ListRoleResponse{}
+		if err := chat.FetchPage(next, res); err != nil {
+			return nil, err
+		}
+		roles = append(roles, res.Roles...)
+	}
+
+	return roles, nil
+}
+
+func (c *Client) chatAPI(ctx context.Context) ChatAPI {
+	if c.chat != nil {
+		return c.chat(ctx)
+	}
+	client := c.RestClient(ctx)
+	return restChat{ApiService: client.ChatV2, restPages: restPages{client: client}}
+}
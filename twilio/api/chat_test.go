@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"testing"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+// pagedChat lists the services, or the roles of a service, of pages, one
+// page per element, and serves the pages after the first through FetchPage.
+type pagedChat struct {
+	ChatAPI
+
+	pages [][]string
+}
+
+func (c *pagedChat) servicePage(i int) *openapi.ListServiceResponse {
+	res := &openapi.ListServiceResponse{}
+	for _, sid := range c.pages[i] {
+		sid := sid
+		res.Services = append(res.Services, openapi.ChatV2Service{Sid: &sid})
+	}
+	if i+1 < len(c.pages) {
+		res.Meta.NextPageUrl = fmt.Sprintf("https://chat.twilio.com/v2/Services?Page=%d", i+1)
+	}
+	return res
+}
+
+func (c *pagedChat) rolePage(i int) *openapi.ListRoleResponse {
+	res := &openapi.ListRoleResponse{}
+	for _, sid := range c.pages[i] {
+		sid := sid
+		res.Roles = append(res.Roles, openapi.ChatV2ServiceRole{Sid: &sid})
+	}
+	if i+1 < len(c.pages) {
+		res.Meta.NextPageUrl = fmt.Sprintf("https://chat.twilio.com/v2/Services/IS1/Roles?Page=%d", i+1)
+	}
+	return res
+}
+
+func (c *pagedChat) ListService(params *openapi.ListServiceParams) (*openapi.ListServiceResponse, error) {
+	return c.servicePage(0), nil
+}
+
+func (c *pagedChat) ListRole(serviceSid string, params *openapi.ListRoleParams) (*openapi.ListRoleResponse, error) {
+	return c.rolePage(0), nil
+}
+
+func (c *pagedChat) FetchPage(pageURL string, v interface{}) error {
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return err
+	}
+	i, err := strconv.Atoi(u.Query().Get("Page"))
+	if err != nil {
+		return err
+	}
+
+	var page interface{}
+	switch u.Path {
+	case "/v2/Services":
+		page = c.servicePage(i)
+	case "/v2/Services/IS1/Roles":
+		page = c.rolePage(i)
+	default:
+		return fmt.Errorf("unexpected page URL %s", pageURL)
+	}
+
+	body, err := json.Marshal(page)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+func TestListServices_fake(t *testing.T) {
+	client := NewClientWithChat(&pagedChat{pages: [][]string{{"IS1", "IS2"}, {"IS3"}, {"IS4"}}})
+
+	services, err := client.ListServices(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sids := []string{}
+	for _, svc := range services {
+		sids = append(sids, *svc.Sid)
+	}
+	if fmt.Sprint(sids) != "[IS1 IS2 IS3 IS4]" {
+		t.Errorf("ListServices = %v, want the services of every page", sids)
+	}
+}
+
+func TestListRoles_fake(t *testing.T) {
+	client := NewClientWithChat(&pagedChat{pages: [][]string{{"RL1", "RL2"}, {"RL3"}, {"RL4"}}})
+
+	roles, err := client.ListRoles(context.Background(), "IS1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sids := []string{}
+	for _, role := range roles {
+		sids = append(sids, *role.Sid)
+	}
+	if fmt.Sprint(sids) != "[RL1 RL2 RL3 RL4]" {
+		t.Errorf("ListRoles = %v, want the roles of every page", sids)
+	}
+}
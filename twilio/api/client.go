@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	tw "github.com/twilio/twilio-go"
+	twclient "github.com/twilio/twilio-go/client"
+)
+
+// requestTimeout bounds a single HTTP request, the same default twilio-go uses.
+const requestTimeout = 10 * time.Second
+
+// defaultRegion is the region Twilio serves requests from when none is selected.
+const defaultRegion = "us1"
+
+// Config holds the provider settings a Client is built from.
+type Config struct {
+	AccountSid string
+	Username   string
+	Password   string
+
+	// MaxRetries is how many times a throttled or failed request is sent again.
+	MaxRetries int
+	// MaxRetryWait caps the wait between two attempts.
+	MaxRetryWait time.Duration
+
+	// MaxConcurrentRequests caps the requests in flight, zero means unlimited.
+	MaxConcurrentRequests int
+	// RequestsPerSecond caps the request rate, zero means unlimited.
+	RequestsPerSecond int
+
+	// Region and Edge select the Twilio data center and edge location.
+	Region string
+	Edge   string
+
+	// Endpoints overrides the base URL of a product, keyed by EndpointProducts.
+	Endpoints map[string]string
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify bool
+}
+
+// Client is the provider meta value shared by every resource.
+type Client struct {
+	accountSid  string
+	credentials *twclient.Credentials
+	transport   http.RoundTripper
+	region      string
+	edge        string
+
+	// chat replaces the twilio-go Chat API, see NewClientWithChat.
+	chat func(ctx context.Context) ChatAPI
+}
+
+// NewClient returns a Client for the given configuration.
+func NewClient(config Config) (*Client, error) {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	if config.InsecureSkipVerify {
+		base.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	transport, err := newEndpointTransport(&retryTransport{
+		next:       newLimitTransport(base, config.MaxConcurrentRequests, config.RequestsPerSecond),
+		maxRetries: config.MaxRetries,
+		maxWait:    config.MaxRetryWait,
+	}, config.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		accountSid:  config.AccountSid,
+		credentials: twclient.NewCredentials(config.Username, config.Password),
+		transport:   transport,
+		region:      config.Region,
+		edge:        config.Edge,
+	}, nil
+}
+
+// Region returns the Twilio region requests are sent to.
+func (c *Client) Region() string {
+	if c.region == "" {
+		return defaultRegion
+	}
+	return c.region
+}
+
+// RestClient returns a Twilio REST client whose requests are bound to ctx,
+// so that cancelling ctx aborts requests that are still in flight.
+func (c *Client) RestClient(ctx context.Context) *tw.RestClient {
+	base := &twclient.Client{
+		Credentials: c.credentials,
+		HTTPClient: &http.Client{
+			Transport: &contextTransport{ctx: ctx, next: c.transport},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+	base.SetAccountSid(c.accountSid)
+
+	client := tw.NewRestClientWithParams(c.credentials.Username, c.credentials.Password, tw.RestClientParams{
+		AccountSid: c.accountSid,
+		Client:     base,
+	})
+	client.SetRegion(c.region)
+	client.SetEdge(c.edge)
+
+	return client
+}
+
+// contextTransport attaches ctx to every request, twilio-go builds its
+// requests without one.
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(t.ctx))
+}
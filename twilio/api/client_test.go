@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRestClient_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Hold the request until the client gives up on it.
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{
+		AccountSid: "AC00000000000000000000000000000000",
+		Username:   "AC00000000000000000000000000000000",
+		Password:   "test",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err = client.RestClient(ctx).Client.SendRequest(http.MethodGet, server.URL, nil, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("SendRequest() error = %v, want %v", err, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("SendRequest() returned after %s, want it aborted when the context was cancelled", elapsed)
+	}
+}
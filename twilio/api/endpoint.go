@@ -0,0 +1,65 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// EndpointProducts lists the Twilio products whose base URL can be overridden.
+var EndpointProducts = []string{
+	"accounts",
+	"api",
+	"chat",
+	"conversations",
+	"messaging",
+	"notify",
+	"studio",
+	"sync",
+	"verify",
+}
+
+// endpointTransport sends the requests of a product to an overridden base URL,
+// such as a local stand-in server. The product is the first label of the
+// Twilio host name, e.g. chat for chat.twilio.com.
+type endpointTransport struct {
+	next      http.RoundTripper
+	endpoints map[string]*url.URL
+}
+
+func newEndpointTransport(next http.RoundTripper, endpoints map[string]string) (http.RoundTripper, error) {
+	if len(endpoints) == 0 {
+		return next, nil
+	}
+
+	t := &endpointTransport{next: next, endpoints: map[string]*url.URL{}}
+	for product, endpoint := range endpoints {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s endpoint: %w", product, err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid %s endpoint %q: an absolute URL is required", product, endpoint)
+		}
+		t.endpoints[product] = u
+	}
+	return t, nil
+}
+
+func (t *endpointTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	product := strings.SplitN(req.URL.Hostname(), ".", 2)[0]
+
+	endpoint, ok := t.endpoints[product]
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	req.URL.Scheme = endpoint.Scheme
+	req.URL.Host = endpoint.Host
+	req.URL.Path = strings.TrimSuffix(endpoint.Path, "/") + req.URL.Path
+	req.Host = ""
+
+	return t.next.RoundTrip(req)
+}
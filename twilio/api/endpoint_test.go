@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEndpointTransport(t *testing.T) {
+	tests := map[string]struct {
+		url  string
+		want string
+	}{
+		"overridden product": {
+			url:  "https://chat.twilio.com/v2/Services?PageSize=50",
+			want: "http://127.0.0.1:8080/twilio/chat/v2/Services?PageSize=50",
+		},
+		"regional host": {
+			url:  "https://chat.dublin.ie1.twilio.com/v2/Services",
+			want: "http://127.0.0.1:8080/twilio/chat/v2/Services",
+		},
+		"product without override": {
+			url:  "https://api.twilio.com/2010-04-01/Accounts.json",
+			want: "https://api.twilio.com/2010-04-01/Accounts.json",
+		},
+		"unknown product": {
+			url:  "https://events.twilio.com/v1/Sinks",
+			want: "https://events.twilio.com/v1/Sinks",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			transport, err := newEndpointTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				got = req.URL.String()
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+			}), map[string]string{"chat": "http://127.0.0.1:8080/twilio/chat/"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("sent to %s, want %s", got, tt.want)
+			}
+			if req.URL.String() != tt.url {
+				t.Errorf("the original request was changed to %s", req.URL)
+			}
+		})
+	}
+}
+
+func TestNewEndpointTransport_invalid(t *testing.T) {
+	for _, endpoint := range []string{"localhost:8080", "/v2", "http://%zz"} {
+		if _, err := newEndpointTransport(http.DefaultTransport, map[string]string{"chat": endpoint}); err == nil {
+			t.Errorf("newEndpointTransport accepted the chat endpoint %q", endpoint)
+		}
+	}
+}
+
+func TestNewClient_InsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"sid": "IS00000000000000000000000000000000"}`))
+	}))
+	defer server.Close()
+
+	for _, insecure := range []bool{false, true} {
+		client, err := NewClient(Config{
+			AccountSid:         parentSid,
+			Username:           parentSid,
+			Password:           "test",
+			Endpoints:          map[string]string{"chat": server.URL},
+			InsecureSkipVerify: insecure,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = client.RestClient(context.Background()).ChatV2.FetchService("IS00000000000000000000000000000000")
+		if insecure && err != nil {
+			t.Errorf("with insecure_skip_verify: %s", err)
+		}
+		if !insecure && err == nil {
+			t.Errorf("the self-signed certificate was accepted without insecure_skip_verify")
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	twclient "github.com/twilio/twilio-go/client"
+)
+
+// ParameterPaths maps the names of Twilio request parameters to the attributes they are set from.
+type ParameterPaths map[string]cty.Path
+
+var quotedParameter = regexp.MustCompile(`'([A-Za-z][A-Za-z0-9.]*)'`)
+
+// path returns the attribute of the parameter named in message, or nil when no known parameter is named.
+func (p ParameterPaths) path(message string) cty.Path {
+	if match := quotedParameter.FindStringSubmatch(message); match != nil {
+		if path, ok := p[match[1]]; ok {
+			return path
+		}
+	}
+
+	// Not every message quotes the parameter, look for the longest name that appears as a word.
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+
+	for _, name := range names {
+		if regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\b`).MatchString(message) {
+			return p[name]
+		}
+	}
+
+	return nil
+}
+
+// IsNotFound reports whether err is a Twilio REST error for a resource that does not exist.
+func IsNotFound(err error) bool {
+	var restErr *twclient.TwilioRestError
+	if errors.As(err, &restErr) {
+		return restErr.Status == http.StatusNotFound
+	}
+	return false
+}
+
+// DiagFromErr converts err into diagnostics like diag.FromErr. Twilio REST errors
+// keep their code and documentation link, and point at the attribute in paths
+// that the rejected parameter is set from.
+func DiagFromErr(err error, paths ParameterPaths) diag.Diagnostics {
+	var restErr *twclient.TwilioRestError
+	if !errors.As(err, &restErr) {
+		return diag.FromErr(err)
+	}
+
+	detail := fmt.Sprintf("Twilio error %d (HTTP %d): %s", restErr.Code, restErr.Status, restErr.Message)
+	if len(restErr.Details) > 0 {
+		details, _ := json.Marshal(restErr.Details)
+		detail += fmt.Sprintf("\nDetails: %s", details)
+	}
+	if restErr.MoreInfo != "" {
+		detail += fmt.Sprintf("\nMore info: %s", restErr.MoreInfo)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Error,
+			Summary:       restErr.Message,
+			Detail:        detail,
+			AttributePath: paths.path(restErr.Message),
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	twclient "github.com/twilio/twilio-go/client"
+)
+
+func TestDiagFromErr(t *testing.T) {
+	paths := ParameterPaths{
+		"FriendlyName":  cty.GetAttrPath("friendly_name"),
+		"PreWebhookUrl": cty.GetAttrPath("webhooks").IndexInt(0).GetAttr("pre_hook_url"),
+		"WebhookMethod": cty.GetAttrPath("webhooks").IndexInt(0).GetAttr("method"),
+	}
+
+	tests := map[string]struct {
+		err    error
+		paths  ParameterPaths
+		want   diag.Diagnostic
+		wantNo bool
+	}{
+		"nil": {
+			err:    nil,
+			wantNo: true,
+		},
+		"plain error": {
+			err: errors.New("connection reset"),
+			want: diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "connection reset",
+			},
+		},
+		"quoted parameter": {
+			err: &twclient.TwilioRestError{
+				Code:     20001,
+				Message:  "Parameter 'PreWebhookUrl' is not valid",
+				MoreInfo: "https://www.twilio.com/docs/errors/20001",
+				Status:   400,
+			},
+			paths: paths,
+			want: diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Parameter 'PreWebhookUrl' is not valid",
+				Detail:        "Twilio error 20001 (HTTP 400): Parameter 'PreWebhookUrl' is not valid\nMore info: https://www.twilio.com/docs/errors/20001",
+				AttributePath: cty.GetAttrPath("webhooks").IndexInt(0).GetAttr("pre_hook_url"),
+			},
+		},
+		"unquoted parameter": {
+			err: &twclient.TwilioRestError{
+				Code:    20001,
+				Message: "Invalid WebhookMethod value",
+				Status:  400,
+			},
+			paths: paths,
+			want: diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid WebhookMethod value",
+				Detail:        "Twilio error 20001 (HTTP 400): Invalid WebhookMethod value",
+				AttributePath: cty.GetAttrPath("webhooks").IndexInt(0).GetAttr("method"),
+			},
+		},
+		"unknown parameter": {
+			err: &twclient.TwilioRestError{
+				Code:    20001,
+				Message: "Parameter 'Limits.UserChannels' is not valid",
+				Details: map[string]interface{}{"max": 500},
+				Status:  400,
+			},
+			paths: paths,
+			want: diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Parameter 'Limits.UserChannels' is not valid",
+				Detail:   "Twilio error 20001 (HTTP 400): Parameter 'Limits.UserChannels' is not valid\nDetails: {\"max\":500}",
+			},
+		},
+		"wrapped": {
+			err: fmt.Errorf("updating service: %w", &twclient.TwilioRestError{
+				Code:    20404,
+				Message: "The requested resource was not found",
+				Status:  404,
+			}),
+			want: diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "The requested resource was not found",
+				Detail:   "Twilio error 20404 (HTTP 404): The requested resource was not found",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := DiagFromErr(tt.err, tt.paths)
+			if tt.wantNo {
+				if len(diags) != 0 {
+					t.Fatalf("DiagFromErr() = %v, want no diagnostics", diags)
+				}
+				return
+			}
+			if len(diags) != 1 {
+				t.Fatalf("DiagFromErr() returned %d diagnostics, want 1", len(diags))
+			}
+
+			got := diags[0]
+			if got.Severity != tt.want.Severity || got.Summary != tt.want.Summary || got.Detail != tt.want.Detail {
+				t.Errorf("DiagFromErr() = %+v, want %+v", got, tt.want)
+			}
+			if !got.AttributePath.Equals(tt.want.AttributePath) {
+				t.Errorf("DiagFromErr() AttributePath = %#v, want %#v", got.AttributePath, tt.want.AttributePath)
+			}
+		})
+	}
+}
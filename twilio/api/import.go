@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ImportStateWithParents imports resources that cannot be fetched without the
+// SIDs of their parents, such as the roles of a service. It accepts IDs of the
+// form parent_sid/.../sid, sets the parents attributes and keeps the last
+// SID as the resource ID.
+func ImportStateWithParents(parents ...string) schema.StateContextFunc {
+	format := strings.Join(append(append([]string{}, parents...), "sid"), "/")
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+		if len(parts) != len(parents)+1 {
+			return nil, fmt.Errorf("unexpected format of ID %q, expected %s", d.Id(), format)
+		}
+		for _, part := range parts {
+			if part == "" {
+				return nil, fmt.Errorf("unexpected format of ID %q, expected %s", d.Id(), format)
+			}
+		}
+
+		for i, parent := range parents {
+			if err := d.Set(parent, parts[i]); err != nil {
+				return nil, err
+			}
+		}
+		d.SetId(parts[len(parents)])
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
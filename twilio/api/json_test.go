@@ -0,0 +1,26 @@
+package api
+
+import "testing"
+
+func TestSuppressEquivalentJSON(t *testing.T) {
+	tests := map[string]struct {
+		old, new string
+		want     bool
+	}{
+		"same":           {`{"a":1}`, `{"a":1}`, true},
+		"whitespace":     {`{"a":1,"b":[1,2]}`, "{\n  \"a\": 1,\n  \"b\": [1, 2]\n}", true},
+		"key order":      {`{"a":1,"b":2}`, `{"b":2,"a":1}`, true},
+		"different":      {`{"a":1}`, `{"a":2}`, false},
+		"array order":    {`[1,2]`, `[2,1]`, false},
+		"invalid":        {`{"a":1}`, `{"a":1`, false},
+		"new attributes": {``, `{}`, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := SuppressEquivalentJSON("attributes", tt.old, tt.new, nil); got != tt.want {
+				t.Errorf("SuppressEquivalentJSON(%q, %q) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newStatusServer(t *testing.T, status int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func sendLimited(transport http.RoundTripper, url string, timeout time.Duration) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return transport.RoundTrip(req)
+}
+
+func TestNewLimitTransport_Disabled(t *testing.T) {
+	if transport := newLimitTransport(http.DefaultTransport, 0, 0); transport != http.DefaultTransport {
+		t.Errorf("newLimitTransport without limits = %T, want the next transport", transport)
+	}
+}
+
+func TestLimitTransport_HoldsSlotUntilBodyClosed(t *testing.T) {
+	server := newStatusServer(t, http.StatusOK, `{}`)
+	transport := newLimitTransport(http.DefaultTransport, 1, 0)
+
+	first, err := sendLimited(transport, server.URL, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sendLimited(transport, server.URL, 50*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("request while the slot is held: got %v, want the deadline to be exceeded", err)
+	}
+
+	first.Body.Close()
+	first.Body.Close()
+
+	res, err := sendLimited(transport, server.URL, time.Second)
+	if err != nil {
+		t.Fatalf("request after the body was closed: %s", err)
+	}
+	res.Body.Close()
+}
+
+func TestLimitTransport_ErrorResponsesReleaseSlots(t *testing.T) {
+	server := newStatusServer(t, http.StatusNotFound, `{"code": 20404, "message": "not found", "status": 404}`)
+	transport := newLimitTransport(http.DefaultTransport, 1, 0)
+
+	// The bodies are left open, like twilio-go does for error responses.
+	for i := 0; i < 3; i++ {
+		res, err := sendLimited(transport, server.URL, time.Second)
+		if err != nil {
+			t.Fatalf("request %d: %s", i+1, err)
+		}
+		if res.StatusCode != http.StatusNotFound {
+			t.Fatalf("request %d: status = %d, want 404", i+1, res.StatusCode)
+		}
+	}
+}
+
+func TestLimitTransport_NotFoundThroughClient(t *testing.T) {
+	server := newStatusServer(t, http.StatusNotFound, `{"code": 20404, "message": "not found", "status": 404}`)
+
+	client, err := NewClient(Config{
+		AccountSid:            "AC00000000000000000000000000000000",
+		Username:              "AC00000000000000000000000000000000",
+		Password:              "test",
+		MaxConcurrentRequests: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.RestClient(ctx).Client.SendRequest(http.MethodGet, server.URL, nil, nil); !IsNotFound(err) {
+			t.Fatalf("request %d: got %v, want a 404", i+1, err)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(10)
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		if err := bucket.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("the burst took %s, want no wait", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := bucket.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait on an empty bucket: got %v, want the deadline to be exceeded", err)
+	}
+
+	start = time.Now()
+	if err := bucket.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Errorf("waited %s for the next token, want about 100ms", elapsed)
+	}
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	tw "github.com/twilio/twilio-go"
+)
+
+// pageSize is the number of resources requested per page when listing.
+const pageSize = 50
+
+// PageAPI fetches the pages of a Twilio list after the first one, which
+// twilio-go does not follow.
+type PageAPI interface {
+	// FetchPage decodes the page at pageURL, the next_page_url of a list
+	// response, into v.
+	FetchPage(pageURL string, v interface{}) error
+}
+
+// restPages fetches pages with a twilio-go REST client.
+type restPages struct {
+	client *tw.RestClient
+}
+
+func (p restPages) FetchPage(pageURL string, v interface{}) error {
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return err
+	}
+	query := u.Query()
+	u.RawQuery = ""
+
+	res, err := p.client.Get(u.String(), query, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// nextPage decodes the page at pageURL of a list outside of the Chat API into v.
+func (c *Client) nextPage(ctx context.Context, pageURL string, v interface{}) error {
+	return restPages{client: c.RestClient(ctx)}.FetchPage(pageURL, v)
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RegionSchema records the region a resource was created in.
+func RegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// SetRegion records the provider region on resources that have none yet,
+// such as newly created or imported ones.
+func SetRegion(d *schema.ResourceData, m interface{}) error {
+	if d.Get("region").(string) != "" {
+		return nil
+	}
+	return d.Set("region", m.(*Client).Region())
+}
+
+// CustomizeDiffRegion fails the plan when the provider targets another region
+// than the one a resource was created in, Twilio resources cannot move between
+// regions and would otherwise be orphaned.
+func CustomizeDiffRegion(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	region := m.(*Client).Region()
+
+	if d.Id() == "" {
+		return d.SetNew("region", region)
+	}
+
+	// Resources created before regions were recorded live in the default one.
+	recorded := d.Get("region").(string)
+	if recorded == "" {
+		recorded = defaultRegion
+	}
+	if recorded != region {
+		return fmt.Errorf("%s was created in region %q but the provider targets region %q, resources cannot be moved between regions", d.Id(), recorded, region)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func regionResource() *schema.Resource {
+	return &schema.Resource{
+		CustomizeDiff: CustomizeDiffRegion,
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"region": RegionSchema(),
+		},
+	}
+}
+
+func regionClient(t *testing.T, region string) *Client {
+	client, err := NewClient(Config{AccountSid: parentSid, Username: parentSid, Password: "test", Region: region})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestCustomizeDiffRegion(t *testing.T) {
+	tests := map[string]struct {
+		state      map[string]string
+		region     string
+		wantRegion string
+		wantErr    *regexp.Regexp
+	}{
+		"new resource": {
+			region:     "ie1",
+			wantRegion: "ie1",
+		},
+		"same region": {
+			state:  map[string]string{"region": "ie1"},
+			region: "ie1",
+		},
+		"other region": {
+			state:   map[string]string{"region": "us1"},
+			region:  "ie1",
+			wantErr: regexp.MustCompile(`IS00000000000000000000000000000000 was created in region "us1" but the provider targets region "ie1"`),
+		},
+		"legacy resource in the default region": {
+			state: map[string]string{"region": ""},
+		},
+		"legacy resource in another region": {
+			state:   map[string]string{"region": ""},
+			region:  "ie1",
+			wantErr: regexp.MustCompile(`created in region "us1" but the provider targets region "ie1"`),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var state *terraform.InstanceState
+			if tt.state != nil {
+				state = &terraform.InstanceState{ID: "IS00000000000000000000000000000000", Attributes: tt.state}
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{})
+
+			diff, err := regionResource().Diff(context.Background(), state, config, regionClient(t, tt.region))
+			if tt.wantErr != nil {
+				if err == nil || !tt.wantErr.MatchString(err.Error()) {
+					t.Fatalf("Diff() error = %v, want a match for %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var region string
+			if diff != nil && diff.Attributes["region"] != nil {
+				region = diff.Attributes["region"].New
+			}
+			if region != tt.wantRegion {
+				t.Errorf("planned region = %q, want %q", region, tt.wantRegion)
+			}
+		})
+	}
+}
+
+func TestSetRegion(t *testing.T) {
+	client := regionClient(t, "ie1")
+
+	d := regionResource().TestResourceData()
+	if err := SetRegion(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if region := d.Get("region").(string); region != "ie1" {
+		t.Errorf("region = %q, want the provider region %q", region, "ie1")
+	}
+
+	if err := d.Set("region", "us1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetRegion(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if region := d.Get("region").(string); region != "us1" {
+		t.Errorf("region = %q, want the recorded region %q to be kept", region, "us1")
+	}
+}
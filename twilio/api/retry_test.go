@@ -0,0 +1,302 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// recordingServer answers the requests it receives with the given status
+// codes in turn, and records their bodies.
+type recordingServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	bodies   []string
+}
+
+func newRecordingServer(t *testing.T, header http.Header, statuses ...int) *recordingServer {
+	s := &recordingServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading the request body: %s", err)
+		}
+
+		s.mu.Lock()
+		status := s.statuses[len(s.bodies)%len(s.statuses)]
+		s.bodies = append(s.bodies, string(body))
+		s.mu.Unlock()
+
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *recordingServer) attempts() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.bodies...)
+}
+
+func sendRetried(t *testing.T, transport *retryTransport, method string, url string, body string) *http.Response {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	return res
+}
+
+func TestRetryTransport(t *testing.T) {
+	retryNow := http.Header{"Retry-After": []string{"0"}}
+
+	tests := map[string]struct {
+		method     string
+		header     http.Header
+		statuses   []int
+		maxRetries int
+		wantStatus int
+		wantTries  int
+	}{
+		"throttled POST is replayed": {
+			method:     http.MethodPost,
+			header:     retryNow,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusCreated},
+			maxRetries: 3,
+			wantStatus: http.StatusCreated,
+			wantTries:  2,
+		},
+		"failed POST is not retried": {
+			method:     http.MethodPost,
+			header:     retryNow,
+			statuses:   []int{http.StatusInternalServerError, http.StatusCreated},
+			maxRetries: 3,
+			wantStatus: http.StatusInternalServerError,
+			wantTries:  1,
+		},
+		"failed GET is retried": {
+			method:     http.MethodGet,
+			header:     retryNow,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 3,
+			wantStatus: http.StatusOK,
+			wantTries:  2,
+		},
+		"retries give up": {
+			method:     http.MethodGet,
+			header:     retryNow,
+			statuses:   []int{http.StatusTooManyRequests},
+			maxRetries: 2,
+			wantStatus: http.StatusTooManyRequests,
+			wantTries:  3,
+		},
+		"zero max retries disables retries": {
+			method:     http.MethodGet,
+			header:     retryNow,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries: 0,
+			wantStatus: http.StatusTooManyRequests,
+			wantTries:  1,
+		},
+		"client errors are not retried": {
+			method:     http.MethodGet,
+			header:     retryNow,
+			statuses:   []int{http.StatusNotFound, http.StatusOK},
+			maxRetries: 3,
+			wantStatus: http.StatusNotFound,
+			wantTries:  1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := newRecordingServer(t, tt.header, tt.statuses...)
+			transport := &retryTransport{next: http.DefaultTransport, maxRetries: tt.maxRetries, maxWait: time.Second}
+
+			res := sendRetried(t, transport, tt.method, server.URL, "FriendlyName=test")
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+
+			attempts := server.attempts()
+			if len(attempts) != tt.wantTries {
+				t.Fatalf("sent %d attempts, want %d", len(attempts), tt.wantTries)
+			}
+			for i, body := range attempts {
+				if body != "FriendlyName=test" {
+					t.Errorf("attempt %d sent body %q, want the original body", i+1, body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := &retryTransport{maxWait: 10 * time.Second}
+	withRetryAfter := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+	}
+
+	tests := map[string]struct {
+		attempt  int
+		res      *http.Response
+		min, max time.Duration
+	}{
+		"first attempt": {
+			attempt: 0,
+			min:     retryBaseWait / 2,
+			max:     retryBaseWait,
+		},
+		"doubled per attempt": {
+			attempt: 2,
+			min:     2 * retryBaseWait,
+			max:     4 * retryBaseWait,
+		},
+		"capped at max wait": {
+			attempt: 10,
+			min:     5 * time.Second,
+			max:     10 * time.Second,
+		},
+		"retry after in seconds": {
+			res: withRetryAfter("3"),
+			min: 3 * time.Second,
+			max: 3 * time.Second,
+		},
+		"retry after capped at max wait": {
+			res: withRetryAfter("120"),
+			min: 10 * time.Second,
+			max: 10 * time.Second,
+		},
+		"retry after as a date capped at max wait": {
+			res: withRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)),
+			min: 10 * time.Second,
+			max: 10 * time.Second,
+		},
+		"invalid retry after falls back to backoff": {
+			res: withRetryAfter("soon"),
+			min: retryBaseWait / 2,
+			max: retryBaseWait,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				if wait := transport.backoff(tt.attempt, tt.res); wait < tt.min || wait > tt.max {
+					t.Fatalf("backoff = %s, want between %s and %s", wait, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		"missing":  {value: "", wantOk: false},
+		"seconds":  {value: "5", want: 5 * time.Second, wantOk: true},
+		"negative": {value: "-1", wantOk: false},
+		"past":     {value: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0, wantOk: true},
+		"invalid":  {value: "soon", wantOk: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := retryAfter(tt.value)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("retryAfter(%q) = %s, %t, want %s, %t", tt.value, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	tests := map[string]struct {
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		"throttled POST":      {method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		"throttled DELETE":    {method: http.MethodDelete, status: http.StatusTooManyRequests, want: true},
+		"bad gateway GET":     {method: http.MethodGet, status: http.StatusBadGateway, want: true},
+		"server error POST":   {method: http.MethodPost, status: http.StatusInternalServerError, want: false},
+		"not implemented GET": {method: http.MethodGet, status: http.StatusNotImplemented, want: false},
+		"bad request GET":     {method: http.MethodGet, status: http.StatusBadRequest, want: false},
+		"success":             {method: http.MethodGet, status: http.StatusOK, want: false},
+		"network error GET":   {method: http.MethodGet, err: errors.New("connection reset"), want: true},
+		"network error POST":  {method: http.MethodPost, err: errors.New("connection reset"), want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "https://chat.twilio.com/v2/Services", nil)
+			var res *http.Response
+			if tt.err == nil {
+				res = &http.Response{StatusCode: tt.status}
+			}
+			if got := shouldRetry(req, res, tt.err); got != tt.want {
+				t.Errorf("shouldRetry = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_ReleasesAttempts(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusNotFound} {
+		var attempt context.Context
+		transport := &retryTransport{
+			next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				attempt = req.Context()
+				return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(`{"status": 404}`))}, nil
+			}),
+			maxWait: time.Second,
+		}
+
+		req, err := http.NewRequest(http.MethodGet, "https://chat.twilio.com/v2/Services", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// twilio-go only closes the body of successful responses.
+		if released := attempt.Err() != nil; released != (status != http.StatusOK) {
+			t.Errorf("status %d: attempt released before the body was closed: %t", status, released)
+		}
+		if body, _ := ioutil.ReadAll(res.Body); string(body) != `{"status": 404}` {
+			t.Errorf("status %d: body = %q", status, body)
+		}
+		res.Body.Close()
+		if attempt.Err() == nil {
+			t.Errorf("status %d: closing the body did not release the attempt", status)
+		}
+	}
+}
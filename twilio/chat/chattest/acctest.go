@@ -0,0 +1,108 @@
+package chattest
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-twilio/twilio"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// ProviderFactories serves the provider to the acceptance tests, combine it
+// with ProviderConfig to send the chat requests to a Server.
+var ProviderFactories = map[string]func() (*schema.Provider, error){
+	"twilio": func() (*schema.Provider, error) {
+		return twilio.Provider(), nil
+	},
+}
+
+// parentSids lists the attributes holding the parent SIDs of each resource
+// type, outermost first like the import IDs.
+var parentSids = map[string][]string{
+	"twilio_chat_service":         {},
+	"twilio_chat_credential":      {},
+	"twilio_chat_fcm_credential":  {},
+	"twilio_chat_apn_credential":  {},
+	"twilio_chat_role":            {"service_sid"},
+	"twilio_chat_channel":         {"service_sid"},
+	"twilio_chat_user":            {"service_sid"},
+	"twilio_chat_channel_member":  {"service_sid", "channel_sid"},
+	"twilio_chat_channel_webhook": {"service_sid", "channel_sid"},
+}
+
+// exists reports whether the resource of the state rs is stored in s.
+func (s *Server) exists(rs *terraform.ResourceState) (bool, error) {
+	attributes, sid := rs.Primary.Attributes, rs.Primary.ID
+
+	var ok bool
+	switch rs.Type {
+	case "twilio_chat_service":
+		_, ok = s.Service(sid)
+	case "twilio_chat_credential", "twilio_chat_fcm_credential", "twilio_chat_apn_credential":
+		_, ok = s.Credential(sid)
+	case "twilio_chat_role":
+		_, ok = s.Role(attributes["service_sid"], sid)
+	case "twilio_chat_channel":
+		_, ok = s.Channel(attributes["service_sid"], sid)
+	case "twilio_chat_user":
+		_, ok = s.User(attributes["service_sid"], sid)
+	case "twilio_chat_channel_member":
+		_, ok = s.Member(attributes["service_sid"], attributes["channel_sid"], sid)
+	case "twilio_chat_channel_webhook":
+		_, ok = s.ChannelWebhook(attributes["service_sid"], attributes["channel_sid"], sid)
+	default:
+		return false, fmt.Errorf("%s resources are not served by chattest", rs.Type)
+	}
+	return ok, nil
+}
+
+// CheckDestroy checks that every resource of the state was deleted from s.
+func (s *Server) CheckDestroy(state *terraform.State) error {
+	for name, rs := range state.RootModule().Resources {
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+		ok, err := s.exists(rs)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("%s %s still exists", name, rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+// CheckExists checks that the resource name is stored in s. sids receive the
+// parent SIDs and the SID of the resource, in the order of its import ID.
+func (s *Server) CheckExists(name string, sids ...*string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+		ok, err := s.exists(rs)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%s %s does not exist", name, rs.Primary.ID)
+		}
+
+		parents := parentSids[rs.Type]
+		if len(sids) > 0 && len(sids) != len(parents)+1 {
+			return fmt.Errorf("%s has %d parents, got %d SIDs to record", rs.Type, len(parents), len(sids))
+		}
+		for i, sid := range sids {
+			if i < len(parents) {
+				*sid = rs.Primary.Attributes[parents[i]]
+			} else {
+				*sid = rs.Primary.ID
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package chattest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+func (s *Server) serveChannels(w http.ResponseWriter, r *http.Request, serviceSid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		s.createChannel(w, r, svc)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveChannel(w http.ResponseWriter, r *http.Request, serviceSid string, sid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+	channel, ok := svc.channel(sid)
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, channel)
+	case http.MethodPost:
+		updateChannel(w, r, svc, channel)
+	case http.MethodDelete:
+		svc.deleteChannel(*channel.Sid)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// channel looks a channel up by SID or unique name, like the API.
+func (svc *service) channel(sidOrUniqueName string) (*openapi.ChatV2ServiceChannel, bool) {
+	if channel, ok := svc.channels[sidOrUniqueName]; ok {
+		return channel, true
+	}
+	for _, channel := range svc.channels {
+		if channel.UniqueName != nil && *channel.UniqueName == sidOrUniqueName {
+			return channel, true
+		}
+	}
+	return nil, false
+}
+
+// deleteChannel deletes the channel sid, its members and its webhooks.
+func (svc *service) deleteChannel(sid string) {
+	for memberSid := range svc.members[sid] {
+		svc.deleteMember(sid, memberSid)
+	}
+	delete(svc.members, sid)
+	delete(svc.webhooks, sid)
+	delete(svc.channels, sid)
+}
+
+func (s *Server) createChannel(w http.ResponseWriter, r *http.Request, svc *service) {
+	form := r.PostForm
+
+	channelType := "public"
+	if v := form.Get("Type"); v != "" {
+		channelType = v
+	}
+	if channelType != "public" && channelType != "private" {
+		writeInvalidParameter(w, "Type")
+		return
+	}
+	if name, ok := invalidChannelParameter(form.Get("Attributes"), form.Get("UniqueName"), svc, ""); ok {
+		writeChannelParameterError(w, name)
+		return
+	}
+
+	sid := s.newSid("CH")
+	created := now()
+	attributes := "{}"
+	if v := form.Get("Attributes"); v != "" {
+		attributes = v
+	}
+	channel := &openapi.ChatV2ServiceChannel{
+		AccountSid:    stringPtr(AccountSid),
+		Attributes:    &attributes,
+		CreatedBy:     stringPtr("system"),
+		DateCreated:   created,
+		DateUpdated:   created,
+		FriendlyName:  stringPtr(form.Get("FriendlyName")),
+		MembersCount:  intPtr(0),
+		MessagesCount: intPtr(0),
+		ServiceSid:    svc.Sid,
+		Sid:           &sid,
+		Type:          &channelType,
+		UniqueName:    stringPtr(form.Get("UniqueName")),
+		Url:           stringPtr(fmt.Sprintf("%s/v2/Services/%s/Channels/%s", s.URL, *svc.Sid, sid)),
+	}
+	if *channel.UniqueName == "" {
+		channel.UniqueName = nil
+	}
+
+	svc.channels[sid] = channel
+	svc.members[sid] = map[string]*openapi.ChatV2ServiceChannelMember{}
+	svc.webhooks[sid] = map[string]*openapi.ChatV2ServiceChannelChannelWebhook{}
+	writeJSON(w, http.StatusCreated, channel)
+}
+
+func updateChannel(w http.ResponseWriter, r *http.Request, svc *service, channel *openapi.ChatV2ServiceChannel) {
+	form := r.PostForm
+
+	if name, ok := invalidChannelParameter(form.Get("Attributes"), form.Get("UniqueName"), svc, *channel.Sid); ok {
+		writeChannelParameterError(w, name)
+		return
+	}
+
+	if v, ok := form["FriendlyName"]; ok {
+		channel.FriendlyName = &v[0]
+	}
+	if v, ok := form["UniqueName"]; ok {
+		channel.UniqueName = &v[0]
+		if v[0] == "" {
+			channel.UniqueName = nil
+		}
+	}
+	if v, ok := form["Attributes"]; ok {
+		channel.Attributes = &v[0]
+	}
+
+	channel.DateUpdated = now()
+	writeJSON(w, http.StatusOK, channel)
+}
+
+// invalidChannelParameter validates the attributes and the unique name of the channel sid, which is empty for new channels.
+func invalidChannelParameter(attributes string, uniqueName string, svc *service, sid string) (string, bool) {
+	if attributes != "" && !json.Valid([]byte(attributes)) {
+		return "Attributes", true
+	}
+	if uniqueName != "" {
+		if other, ok := svc.channel(uniqueName); ok && *other.Sid != sid {
+			return "UniqueName", true
+		}
+	}
+	return "", false
+}
+
+func writeChannelParameterError(w http.ResponseWriter, name string) {
+	if name == "UniqueName" {
+		writeError(w, http.StatusConflict, 50307, "Channel with provided unique name already exists")
+		return
+	}
+	writeInvalidParameter(w, name)
+}
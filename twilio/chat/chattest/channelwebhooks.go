@@ -0,0 +1,183 @@
+package chattest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+func (s *Server) serveChannelWebhooks(w http.ResponseWriter, r *http.Request, serviceSid string, channelSid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+	channel, ok := svc.channel(channelSid)
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		s.createChannelWebhook(w, r, svc, channel)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveChannelWebhook(w http.ResponseWriter, r *http.Request, serviceSid string, channelSid string, sid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+	channel, ok := svc.channel(channelSid)
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+	webhook, ok := svc.webhooks[*channel.Sid][sid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, webhook)
+	case http.MethodPost:
+		updateChannelWebhook(w, r, webhook)
+	case http.MethodDelete:
+		delete(svc.webhooks[*channel.Sid], sid)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) createChannelWebhook(w http.ResponseWriter, r *http.Request, svc *service, channel *openapi.ChatV2ServiceChannel) {
+	form := r.PostForm
+
+	webhookType := form.Get("Type")
+	if webhookType == "" {
+		writeError(w, http.StatusBadRequest, 20001, "Missing required parameter Type in the post body")
+		return
+	}
+	if webhookType != "webhook" && webhookType != "trigger" && webhookType != "studio" {
+		writeInvalidParameter(w, "Type")
+		return
+	}
+
+	// Twilio only reports the settings that apply to the type of the webhook.
+	configuration := map[string]interface{}{"retry_count": 0}
+	if webhookType != "studio" {
+		configuration["method"] = "POST"
+	}
+	if name, ok := applyChannelWebhookForm(configuration, form); ok {
+		writeInvalidParameter(w, name)
+		return
+	}
+	if name, ok := missingChannelWebhookParameter(webhookType, configuration); ok {
+		writeError(w, http.StatusBadRequest, 20001, fmt.Sprintf("Missing required parameter %s in the post body", name))
+		return
+	}
+
+	sid := s.newSid("WH")
+	created := now()
+	webhook := &openapi.ChatV2ServiceChannelChannelWebhook{
+		AccountSid:    stringPtr(AccountSid),
+		ChannelSid:    channel.Sid,
+		Configuration: &configuration,
+		DateCreated:   created,
+		DateUpdated:   created,
+		ServiceSid:    svc.Sid,
+		Sid:           &sid,
+		Type:          &webhookType,
+		Url:           stringPtr(fmt.Sprintf("%s/v2/Services/%s/Channels/%s/Webhooks/%s", s.URL, *svc.Sid, *channel.Sid, sid)),
+	}
+
+	svc.webhooks[*channel.Sid][sid] = webhook
+	writeJSON(w, http.StatusCreated, webhook)
+}
+
+func updateChannelWebhook(w http.ResponseWriter, r *http.Request, webhook *openapi.ChatV2ServiceChannelChannelWebhook) {
+	configuration := map[string]interface{}{}
+	for k, v := range *webhook.Configuration {
+		configuration[k] = v
+	}
+	if name, ok := applyChannelWebhookForm(configuration, r.PostForm); ok {
+		writeInvalidParameter(w, name)
+		return
+	}
+
+	webhook.Configuration = &configuration
+	webhook.DateUpdated = now()
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+// applyChannelWebhookForm copies the Configuration parameters of form to configuration.
+// It returns the name of the first invalid parameter.
+func applyChannelWebhookForm(configuration map[string]interface{}, form url.Values) (string, bool) {
+	if v := form.Get("Configuration.Url"); v != "" {
+		configuration["url"] = v
+	}
+	if v := form.Get("Configuration.Method"); v != "" {
+		if v != "GET" && v != "POST" {
+			return "Configuration.Method", true
+		}
+		configuration["method"] = v
+	}
+	if values, ok := form["Configuration.Filters"]; ok {
+		filters := []interface{}{}
+		for _, event := range values {
+			if !containsString(webhookEvents, event) {
+				return "Configuration.Filters", true
+			}
+			filters = append(filters, event)
+		}
+		configuration["filters"] = filters
+	}
+	if values, ok := form["Configuration.Triggers"]; ok {
+		triggers := []interface{}{}
+		for _, trigger := range values {
+			triggers = append(triggers, trigger)
+		}
+		configuration["triggers"] = triggers
+	}
+	if v := form.Get("Configuration.FlowSid"); v != "" {
+		configuration["flow_sid"] = v
+	}
+	if v := form.Get("Configuration.RetryCount"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > 3 {
+			return "Configuration.RetryCount", true
+		}
+		configuration["retry_count"] = n
+	}
+	return "", false
+}
+
+// missingChannelWebhookParameter returns the name of a setting that a webhook of type webhookType requires but configuration lacks.
+func missingChannelWebhookParameter(webhookType string, configuration map[string]interface{}) (string, bool) {
+	required := map[string][]string{
+		"webhook": {"url", "filters"},
+		"trigger": {"url", "triggers"},
+		"studio":  {"flow_sid"},
+	}
+	names := map[string]string{
+		"url":      "Configuration.Url",
+		"filters":  "Configuration.Filters",
+		"triggers": "Configuration.Triggers",
+		"flow_sid": "Configuration.FlowSid",
+	}
+	for _, key := range required[webhookType] {
+		if _, ok := configuration[key]; !ok {
+			return names[key], true
+		}
+	}
+	return "", false
+}
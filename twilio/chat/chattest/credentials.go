@@ -0,0 +1,126 @@
+package chattest
+
+import (
+	"fmt"
+	"net/http"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+func (s *Server) serveCredentials(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		s.createCredential(w, r)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveCredential(w http.ResponseWriter, r *http.Request, sid string) {
+	cred, ok := s.credentials[sid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, cred.ChatV2Credential)
+	case http.MethodPost:
+		s.updateCredential(w, r, cred)
+	case http.MethodDelete:
+		delete(s.credentials, sid)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) createCredential(w http.ResponseWriter, r *http.Request) {
+	form := r.PostForm
+
+	credentialType := form.Get("Type")
+	switch credentialType {
+	case "fcm":
+		if form.Get("Secret") == "" {
+			writeInvalidParameter(w, "Secret")
+			return
+		}
+	case "gcm":
+		if form.Get("ApiKey") == "" {
+			writeInvalidParameter(w, "ApiKey")
+			return
+		}
+	case "apn":
+		if form.Get("Certificate") == "" {
+			writeInvalidParameter(w, "Certificate")
+			return
+		}
+		if form.Get("PrivateKey") == "" {
+			writeInvalidParameter(w, "PrivateKey")
+			return
+		}
+	default:
+		writeInvalidParameter(w, "Type")
+		return
+	}
+
+	sid := s.newSid("CR")
+	created := now()
+	friendlyName := form.Get("FriendlyName")
+
+	cred := &credential{
+		ChatV2Credential: openapi.ChatV2Credential{
+			AccountSid:   stringPtr(AccountSid),
+			DateCreated:  created,
+			DateUpdated:  created,
+			FriendlyName: &friendlyName,
+			Sid:          &sid,
+			Type:         &credentialType,
+			Url:          stringPtr(fmt.Sprintf("%s/v2/Credentials/%s", s.URL, sid)),
+		},
+	}
+	if credentialType == "apn" {
+		cred.Sandbox = stringPtr("False")
+	}
+
+	applyCredentialForm(cred, r)
+
+	s.credentials[sid] = cred
+	writeJSON(w, http.StatusCreated, cred.ChatV2Credential)
+}
+
+func (s *Server) updateCredential(w http.ResponseWriter, r *http.Request, cred *credential) {
+	applyCredentialForm(cred, r)
+
+	cred.DateUpdated = now()
+	writeJSON(w, http.StatusOK, cred.ChatV2Credential)
+}
+
+func applyCredentialForm(cred *credential, r *http.Request) {
+	form := r.PostForm
+
+	if v, ok := form["FriendlyName"]; ok {
+		cred.FriendlyName = &v[0]
+	}
+	if v, ok := form["Secret"]; ok {
+		cred.secret = v[0]
+		cred.secretWrites++
+	}
+	if v, ok := form["ApiKey"]; ok {
+		cred.apiKey = v[0]
+	}
+	if v, ok := form["Certificate"]; ok {
+		cred.certificate = v[0]
+	}
+	if v, ok := form["PrivateKey"]; ok {
+		cred.privateKey = v[0]
+	}
+	if v, ok := form["Sandbox"]; ok && *cred.Type == "apn" {
+		if v[0] == "true" {
+			cred.Sandbox = stringPtr("True")
+		} else {
+			cred.Sandbox = stringPtr("False")
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package chattest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"time"
+)
+
+// NewCertificate returns a PEM encoded self-signed certificate for commonName
+// and its private key, standing in for the APNs certificates Apple issues.
+func NewCertificate(commonName string) (certificate string, privateKey string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+
+	privateKey = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+	return RenewCertificate(commonName, privateKey), privateKey
+}
+
+// RenewCertificate returns a new PEM encoded self-signed certificate for
+// commonName that keeps the private key returned by NewCertificate.
+func RenewCertificate(commonName string, privateKey string) string {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		panic("chattest: the private key is not PEM encoded")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		panic(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// NewFCMServerKey returns a key shaped like the server keys of the Firebase
+// console. The same seed always returns the same key.
+func NewFCMServerKey(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	digest := hex.EncodeToString(sum[:])
+	return "AAAA" + digest[:7] + ":APA91b" + digest + digest[:60]
+}
@@ -0,0 +1,189 @@
+package chattest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+func (s *Server) serveMembers(w http.ResponseWriter, r *http.Request, serviceSid string, channelSid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+	channel, ok := svc.channel(channelSid)
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		s.createMember(w, r, svc, channel)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveMember(w http.ResponseWriter, r *http.Request, serviceSid string, channelSid string, sid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+	channel, ok := svc.channel(channelSid)
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+	member, ok := svc.member(*channel.Sid, sid)
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, member)
+	case http.MethodPost:
+		updateMember(w, r, svc, member)
+	case http.MethodDelete:
+		svc.deleteMember(*channel.Sid, *member.Sid)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// member looks a member of the channel channelSid up by SID or identity, like the API.
+func (svc *service) member(channelSid string, sidOrIdentity string) (*openapi.ChatV2ServiceChannelMember, bool) {
+	members := svc.members[channelSid]
+	if member, ok := members[sidOrIdentity]; ok {
+		return member, true
+	}
+	for _, member := range members {
+		if *member.Identity == sidOrIdentity {
+			return member, true
+		}
+	}
+	return nil, false
+}
+
+// deleteMember removes the member sid from the channel channelSid and updates the counts.
+func (svc *service) deleteMember(channelSid string, sid string) {
+	member, ok := svc.members[channelSid][sid]
+	if !ok {
+		return
+	}
+	delete(svc.members[channelSid], sid)
+
+	if channel, ok := svc.channels[channelSid]; ok {
+		*channel.MembersCount--
+	}
+	if user, ok := svc.user(*member.Identity); ok {
+		*user.JoinedChannelsCount--
+	}
+}
+
+func (s *Server) createMember(w http.ResponseWriter, r *http.Request, svc *service, channel *openapi.ChatV2ServiceChannel) {
+	form := r.PostForm
+
+	identity := form.Get("Identity")
+	if identity == "" {
+		writeError(w, http.StatusBadRequest, 20001, "Missing required parameter Identity in the post body")
+		return
+	}
+	if _, ok := svc.member(*channel.Sid, identity); ok {
+		writeError(w, http.StatusConflict, 50404, "Member already exists")
+		return
+	}
+	if name, ok := invalidMemberParameter(form, svc); ok {
+		writeInvalidParameter(w, name)
+		return
+	}
+
+	// Twilio creates the users that join a channel before they exist.
+	user, ok := svc.user(identity)
+	if !ok {
+		userSid := s.newSid("US")
+		created := now()
+		user = &openapi.ChatV2ServiceUser{
+			AccountSid:          stringPtr(AccountSid),
+			Attributes:          stringPtr("{}"),
+			DateCreated:         created,
+			DateUpdated:         created,
+			Identity:            stringPtr(identity),
+			IsNotifiable:        boolPtr(false),
+			IsOnline:            boolPtr(false),
+			JoinedChannelsCount: intPtr(0),
+			RoleSid:             svc.DefaultServiceRoleSid,
+			ServiceSid:          svc.Sid,
+			Sid:                 &userSid,
+			Url:                 stringPtr(fmt.Sprintf("%s/v2/Services/%s/Users/%s", s.URL, *svc.Sid, userSid)),
+		}
+		svc.users[userSid] = user
+	}
+
+	sid := s.newSid("MB")
+	created := now()
+	member := &openapi.ChatV2ServiceChannelMember{
+		AccountSid:  stringPtr(AccountSid),
+		Attributes:  stringPtr("{}"),
+		ChannelSid:  channel.Sid,
+		DateCreated: created,
+		DateUpdated: created,
+		Identity:    &identity,
+		RoleSid:     svc.DefaultChannelRoleSid,
+		ServiceSid:  svc.Sid,
+		Sid:         &sid,
+		Url:         stringPtr(fmt.Sprintf("%s/v2/Services/%s/Channels/%s/Members/%s", s.URL, *svc.Sid, *channel.Sid, sid)),
+	}
+	if v := form.Get("Attributes"); v != "" {
+		member.Attributes = &v
+	}
+	if v := form.Get("RoleSid"); v != "" {
+		member.RoleSid = &v
+	}
+
+	svc.members[*channel.Sid][sid] = member
+	*channel.MembersCount++
+	*user.JoinedChannelsCount++
+	writeJSON(w, http.StatusCreated, member)
+}
+
+func updateMember(w http.ResponseWriter, r *http.Request, svc *service, member *openapi.ChatV2ServiceChannelMember) {
+	form := r.PostForm
+
+	if name, ok := invalidMemberParameter(form, svc); ok {
+		writeInvalidParameter(w, name)
+		return
+	}
+
+	if v, ok := form["Attributes"]; ok {
+		member.Attributes = &v[0]
+	}
+	if v := form.Get("RoleSid"); v != "" {
+		member.RoleSid = &v
+	}
+
+	member.DateUpdated = now()
+	writeJSON(w, http.StatusOK, member)
+}
+
+// invalidMemberParameter checks that the role of a member is a channel role of the service and that its attributes are JSON.
+func invalidMemberParameter(form url.Values, svc *service) (string, bool) {
+	if v, ok := form["RoleSid"]; ok && v[0] != "" {
+		role, ok := svc.roles[v[0]]
+		if !ok || *role.Type != "channel" {
+			return "RoleSid", true
+		}
+	}
+	if v, ok := form["Attributes"]; ok && v[0] != "" && !json.Valid([]byte(v[0])) {
+		return "Attributes", true
+	}
+	return "", false
+}
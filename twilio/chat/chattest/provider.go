@@ -0,0 +1,20 @@
+package chattest
+
+import (
+	"fmt"
+)
+
+// ProviderConfig returns a provider block that sends the chat requests to s.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "twilio" {
+  account_sid = %q
+  auth_token  = "test"
+  max_retries = 0
+
+  endpoints {
+    chat = %q
+  }
+}
+`, AccountSid, s.URL)
+}
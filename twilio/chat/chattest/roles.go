@@ -0,0 +1,159 @@
+package chattest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+// permissions are the permissions Twilio accepts for a role.
+var permissions = []string{
+	"addMember",
+	"createChannel",
+	"deleteAnyMessage",
+	"deleteOwnMessage",
+	"destroyChannel",
+	"editAnyMessage",
+	"editAnyMessageAttributes",
+	"editAnyUserInfo",
+	"editChannelAttributes",
+	"editChannelName",
+	"editNotificationLevel",
+	"editOwnMessage",
+	"editOwnMessageAttributes",
+	"editOwnUserInfo",
+	"inviteMember",
+	"joinChannel",
+	"leaveChannel",
+	"removeMember",
+	"sendMediaMessage",
+	"sendMessage",
+}
+
+func (s *Server) serveRoles(w http.ResponseWriter, r *http.Request, serviceSid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.listRoles(w, r, svc)
+	case http.MethodPost:
+		s.createRole(w, r, svc)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveRole(w http.ResponseWriter, r *http.Request, serviceSid string, sid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+	role, ok := svc.roles[sid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, role)
+	case http.MethodPost:
+		updateRole(w, r, role)
+	case http.MethodDelete:
+		delete(svc.roles, sid)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) listRoles(w http.ResponseWriter, r *http.Request, svc *service) {
+	sids := []string{}
+	for sid := range svc.roles {
+		sids = append(sids, sid)
+	}
+	sort.Strings(sids)
+
+	roles := []interface{}{}
+	for _, sid := range sids {
+		roles = append(roles, svc.roles[sid])
+	}
+	s.writePage(w, r, "roles", roles)
+}
+
+func (s *Server) createRole(w http.ResponseWriter, r *http.Request, svc *service) {
+	form := r.PostForm
+
+	friendlyName := form.Get("FriendlyName")
+	if friendlyName == "" {
+		writeError(w, http.StatusBadRequest, 20001, "Missing required parameter FriendlyName in the post body")
+		return
+	}
+	roleType := form.Get("Type")
+	if roleType != "channel" && roleType != "deployment" {
+		writeInvalidParameter(w, "Type")
+		return
+	}
+	granted, ok := validPermissions(form["Permission"])
+	if !ok {
+		writeInvalidParameter(w, "Permission")
+		return
+	}
+
+	sid := s.newSid("RL")
+	created := now()
+	role := &openapi.ChatV2ServiceRole{
+		AccountSid:   stringPtr(AccountSid),
+		DateCreated:  created,
+		DateUpdated:  created,
+		FriendlyName: &friendlyName,
+		Permissions:  &granted,
+		ServiceSid:   svc.Sid,
+		Sid:          &sid,
+		Type:         &roleType,
+		Url:          stringPtr(fmt.Sprintf("%s/v2/Services/%s/Roles/%s", s.URL, *svc.Sid, sid)),
+	}
+
+	svc.roles[sid] = role
+	writeJSON(w, http.StatusCreated, role)
+}
+
+func updateRole(w http.ResponseWriter, r *http.Request, role *openapi.ChatV2ServiceRole) {
+	granted, ok := validPermissions(r.PostForm["Permission"])
+	if !ok {
+		writeInvalidParameter(w, "Permission")
+		return
+	}
+
+	role.Permissions = &granted
+	role.DateUpdated = now()
+	writeJSON(w, http.StatusOK, role)
+}
+
+// validPermissions returns the requested permissions in Twilio's order, or
+// false when none or an unknown permission is requested.
+func validPermissions(requested []string) ([]string, bool) {
+	if len(requested) == 0 {
+		return nil, false
+	}
+	for _, p := range requested {
+		if !containsString(permissions, p) {
+			return nil, false
+		}
+	}
+
+	granted := []string{}
+	for _, p := range permissions {
+		if containsString(requested, p) {
+			granted = append(granted, p)
+		}
+	}
+	return granted, true
+}
@@ -0,0 +1,203 @@
+// Package chattest provides an in-memory stand-in for the Twilio Chat v2 API,
+// so that resources can be exercised without a Twilio account.
+package chattest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+// AccountSid is the account every fake resource belongs to.
+const AccountSid = "ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+// Server serves the Chat v2 Services, Roles, Channels, Members, channel
+// Webhooks, Users and Credentials endpoints from memory.
+// Use it as the chat endpoint of the provider.
+type Server struct {
+	*httptest.Server
+
+	// MaxPageSize caps the number of resources in a page of a list, so that
+	// tests can exercise paging with a few resources. Defaults to 1000.
+	MaxPageSize int
+
+	mu          sync.Mutex
+	sequence    int
+	services    map[string]*service
+	credentials map[string]*credential
+}
+
+type service struct {
+	openapi.ChatV2Service
+	roles    map[string]*openapi.ChatV2ServiceRole
+	channels map[string]*openapi.ChatV2ServiceChannel
+	users    map[string]*openapi.ChatV2ServiceUser
+	// members are keyed by channel SID, then by member SID.
+	members map[string]map[string]*openapi.ChatV2ServiceChannelMember
+	// webhooks are keyed by channel SID, then by webhook SID.
+	webhooks map[string]map[string]*openapi.ChatV2ServiceChannelChannelWebhook
+}
+
+type credential struct {
+	openapi.ChatV2Credential
+	secret      string
+	apiKey      string
+	certificate string
+	privateKey  string
+	// secretWrites counts the requests that set the FCM secret.
+	secretWrites int
+}
+
+// NewServer starts a Server, callers should Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		MaxPageSize: 1000,
+		services:    map[string]*service{},
+		credentials: map[string]*credential{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, 20001, err.Error())
+		return
+	}
+
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(path) < 2 || path[0] != "v2" {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch {
+	case path[1] == "Services" && len(path) == 2:
+		s.serveServices(w, r)
+	case path[1] == "Services" && len(path) == 3:
+		s.serveService(w, r, path[2])
+	case path[1] == "Services" && len(path) == 4 && path[3] == "Roles":
+		s.serveRoles(w, r, path[2])
+	case path[1] == "Services" && len(path) == 5 && path[3] == "Roles":
+		s.serveRole(w, r, path[2], path[4])
+	case path[1] == "Services" && len(path) == 4 && path[3] == "Users":
+		s.serveUsers(w, r, path[2])
+	case path[1] == "Services" && len(path) == 5 && path[3] == "Users":
+		s.serveUser(w, r, path[2], path[4])
+	case path[1] == "Services" && len(path) == 4 && path[3] == "Channels":
+		s.serveChannels(w, r, path[2])
+	case path[1] == "Services" && len(path) == 5 && path[3] == "Channels":
+		s.serveChannel(w, r, path[2], path[4])
+	case path[1] == "Services" && len(path) == 6 && path[3] == "Channels" && path[5] == "Members":
+		s.serveMembers(w, r, path[2], path[4])
+	case path[1] == "Services" && len(path) == 7 && path[3] == "Channels" && path[5] == "Members":
+		s.serveMember(w, r, path[2], path[4], path[6])
+	case path[1] == "Services" && len(path) == 6 && path[3] == "Channels" && path[5] == "Webhooks":
+		s.serveChannelWebhooks(w, r, path[2], path[4])
+	case path[1] == "Services" && len(path) == 7 && path[3] == "Channels" && path[5] == "Webhooks":
+		s.serveChannelWebhook(w, r, path[2], path[4], path[6])
+	case path[1] == "Credentials" && len(path) == 2:
+		s.serveCredentials(w, r)
+	case path[1] == "Credentials" && len(path) == 3:
+		s.serveCredential(w, r, path[2])
+	default:
+		writeNotFound(w, r)
+	}
+}
+
+// Sid returns the SID of the nth resource created by a Server. Resources are
+// numbered in creation order across all types, starting at 1, and a new
+// service is immediately followed by its default roles: service admin,
+// service user, channel admin and channel user.
+func Sid(prefix string, n int) string {
+	return fmt.Sprintf("%s%032x", prefix, n)
+}
+
+func (s *Server) newSid(prefix string) string {
+	s.sequence++
+	return Sid(prefix, s.sequence)
+}
+
+func now() *time.Time {
+	t := time.Now().UTC().Truncate(time.Second)
+	return &t
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"code":      code,
+		"message":   message,
+		"more_info": fmt.Sprintf("https://www.twilio.com/docs/errors/%d", code),
+		"status":    status,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotFound, 20404, fmt.Sprintf("The requested resource %s was not found", r.URL.Path))
+}
+
+func writeInvalidParameter(w http.ResponseWriter, name string) {
+	writeError(w, http.StatusBadRequest, 20001, fmt.Sprintf("Parameter '%s' is not valid", name))
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, 20004, fmt.Sprintf("Method %s not allowed", r.Method))
+}
+
+// writePage writes the page of items selected by the PageSize and Page
+// parameters under key, with page URLs on the Twilio host like the API.
+func (s *Server) writePage(w http.ResponseWriter, r *http.Request, key string, items []interface{}) {
+	size, err := strconv.Atoi(r.Form.Get("PageSize"))
+	if err != nil || size <= 0 {
+		size = 50
+	}
+	if size > s.MaxPageSize {
+		size = s.MaxPageSize
+	}
+	page, _ := strconv.Atoi(r.Form.Get("Page"))
+
+	start := page * size
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+
+	pageURL := func(page int) string {
+		return fmt.Sprintf("https://chat.twilio.com%s?PageSize=%d&Page=%d", r.URL.Path, size, page)
+	}
+	nextPageURL := ""
+	if end < len(items) {
+		nextPageURL = pageURL(page + 1)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		key: items[start:end],
+		"meta": map[string]interface{}{
+			"first_page_url": pageURL(0),
+			"key":            key,
+			"next_page_url":  nextPageURL,
+			"page":           page,
+			"page_size":      size,
+			"url":            pageURL(page),
+		},
+	})
+}
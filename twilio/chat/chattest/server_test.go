@@ -0,0 +1,416 @@
+package chattest_test
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/chattest"
+
+	tw "github.com/twilio/twilio-go"
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+func newRestClient(t *testing.T, server *chattest.Server) *tw.RestClient {
+	client, err := api.NewClient(api.Config{
+		AccountSid: chattest.AccountSid,
+		Username:   chattest.AccountSid,
+		Password:   "test",
+		Endpoints:  map[string]string{"chat": server.URL},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.RestClient(context.Background())
+}
+
+func TestServer_Service(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	client := newRestClient(t, server)
+
+	friendlyName := "test"
+	created, err := client.ChatV2.CreateService(&openapi.CreateServiceParams{FriendlyName: &friendlyName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *created.FriendlyName != friendlyName {
+		t.Errorf("friendly_name = %q, want %q", *created.FriendlyName, friendlyName)
+	}
+	if created.DefaultServiceRoleSid == nil || created.Limits == nil || created.Notifications == nil {
+		t.Errorf("service defaults are missing: %+v", created)
+	}
+
+	params := &openapi.UpdateServiceParams{}
+	params.SetPreWebhookUrl("https://example.com/pre")
+	params.SetWebhookFilters([]string{"onMessageSend", "onMessageSent"})
+	params.SetLimitsChannelMembers(50)
+	params.SetNotificationsNewMessageEnabled(true)
+	params.SetNotificationsNewMessageTemplate("${USER}: ${MESSAGE}")
+
+	if _, err := client.ChatV2.UpdateService(*created.Sid, params); err != nil {
+		t.Fatal(err)
+	}
+
+	fetched, err := client.ChatV2.FetchService(*created.Sid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *fetched.PreWebhookUrl != "https://example.com/pre" {
+		t.Errorf("pre_webhook_url = %q", *fetched.PreWebhookUrl)
+	}
+	if len(*fetched.WebhookFilters) != 2 {
+		t.Errorf("webhook_filters = %v", *fetched.WebhookFilters)
+	}
+	if (*fetched.Limits)["channel_members"].(float64) != 50 {
+		t.Errorf("limits = %v", *fetched.Limits)
+	}
+	newMessage := (*fetched.Notifications)["new_message"].(map[string]interface{})
+	if newMessage["enabled"] != true || newMessage["template"] != "${USER}: ${MESSAGE}" {
+		t.Errorf("notifications.new_message = %v", newMessage)
+	}
+
+	if err := client.ChatV2.DeleteService(*created.Sid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ChatV2.FetchService(*created.Sid); !api.IsNotFound(err) {
+		t.Errorf("FetchService after delete: got %v, want a 404", err)
+	}
+}
+
+func TestServer_ListServices(t *testing.T) {
+	server := chattest.NewServer()
+	server.MaxPageSize = 2
+	defer server.Close()
+
+	client, err := api.NewClient(api.Config{
+		AccountSid: chattest.AccountSid,
+		Username:   chattest.AccountSid,
+		Password:   "test",
+		Endpoints:  map[string]string{"chat": server.URL},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	for _, friendlyName := range []string{"a", "b", "c"} {
+		if _, err := client.Services(ctx).CreateService((&openapi.CreateServiceParams{}).SetFriendlyName(friendlyName)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	services, err := client.ListServices(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 3 {
+		t.Fatalf("listed %d services across pages, want 3", len(services))
+	}
+}
+
+func TestServer_ServiceRejectsInvalidUpdate(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	client := newRestClient(t, server)
+
+	friendlyName := "test"
+	created, err := client.ChatV2.CreateService(&openapi.CreateServiceParams{FriendlyName: &friendlyName})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]*openapi.UpdateServiceParams{
+		"unknown role":      (&openapi.UpdateServiceParams{}).SetDefaultServiceRoleSid("RL00000000000000000000000000000000"),
+		"channel role type": (&openapi.UpdateServiceParams{}).SetDefaultChannelRoleSid(*created.DefaultServiceRoleSid),
+		"webhook url":       (&openapi.UpdateServiceParams{}).SetPreWebhookUrl("not a url"),
+		"limits":            (&openapi.UpdateServiceParams{}).SetLimitsUserChannels(0),
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.SetFriendlyName("changed")
+			if _, err := client.ChatV2.UpdateService(*created.Sid, params); err == nil {
+				t.Fatal("UpdateService succeeded, want an error")
+			}
+
+			fetched, _ := server.Service(*created.Sid)
+			if *fetched.FriendlyName != friendlyName {
+				t.Errorf("a rejected update changed friendly_name to %q", *fetched.FriendlyName)
+			}
+		})
+	}
+}
+
+func TestServer_Credential(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	client := newRestClient(t, server)
+
+	created, err := client.ChatV2.CreateCredential((&openapi.CreateCredentialParams{}).
+		SetFriendlyName("test").
+		SetType("fcm").
+		SetSecret("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.CredentialSecret(*created.Sid) != "secret" {
+		t.Errorf("secret was not stored")
+	}
+
+	if _, err := client.ChatV2.UpdateCredential(*created.Sid, (&openapi.UpdateCredentialParams{}).SetSecret("rotated")); err != nil {
+		t.Fatal(err)
+	}
+	if server.CredentialSecret(*created.Sid) != "rotated" {
+		t.Errorf("secret was not rotated")
+	}
+
+	if _, err := client.ChatV2.CreateCredential((&openapi.CreateCredentialParams{}).SetType("fcm")); err == nil {
+		t.Errorf("CreateCredential without a secret succeeded")
+	}
+
+	if err := client.ChatV2.DeleteCredential(*created.Sid); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ChatV2.DeleteCredential(*created.Sid); !api.IsNotFound(err) {
+		t.Errorf("DeleteCredential after delete: got %v, want a 404", err)
+	}
+}
+
+func TestServer_Role(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	client := newRestClient(t, server)
+
+	svc, err := client.ChatV2.CreateService((&openapi.CreateServiceParams{}).SetFriendlyName("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := client.ChatV2.CreateRole(*svc.Sid, (&openapi.CreateRoleParams{}).
+		SetFriendlyName("moderator").
+		SetType("channel").
+		SetPermission([]string{"sendMessage", "removeMember"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *created.ServiceSid != *svc.Sid || len(*created.Permissions) != 2 {
+		t.Errorf("created role = %+v", created)
+	}
+
+	if _, err := client.ChatV2.UpdateService(*svc.Sid, (&openapi.UpdateServiceParams{}).SetDefaultChannelRoleSid(*created.Sid)); err != nil {
+		t.Errorf("the new role cannot be the default channel role: %v", err)
+	}
+
+	updated, err := client.ChatV2.UpdateRole(*svc.Sid, *created.Sid, (&openapi.UpdateRoleParams{}).SetPermission([]string{"sendMessage"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*updated.Permissions) != 1 {
+		t.Errorf("permissions = %v", *updated.Permissions)
+	}
+
+	if _, err := client.ChatV2.UpdateRole(*svc.Sid, *created.Sid, (&openapi.UpdateRoleParams{}).SetPermission([]string{"fly"})); err == nil {
+		t.Errorf("UpdateRole with an unknown permission succeeded")
+	}
+	if _, err := client.ChatV2.CreateRole(*svc.Sid, (&openapi.CreateRoleParams{}).SetFriendlyName("x").SetType("global").SetPermission([]string{"sendMessage"})); err == nil {
+		t.Errorf("CreateRole with an unknown type succeeded")
+	}
+
+	if err := client.ChatV2.DeleteRole(*svc.Sid, *created.Sid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ChatV2.FetchRole(*svc.Sid, *created.Sid); !api.IsNotFound(err) {
+		t.Errorf("FetchRole after delete: got %v, want a 404", err)
+	}
+}
+
+func TestServer_Channel(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	client := newRestClient(t, server)
+
+	svc, err := client.ChatV2.CreateService((&openapi.CreateServiceParams{}).SetFriendlyName("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := client.ChatV2.CreateChannel(*svc.Sid, (&openapi.CreateChannelParams{}).
+		SetFriendlyName("Announcements").
+		SetUniqueName("announcements"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *created.Type != "public" || *created.Attributes != "{}" {
+		t.Errorf("channel defaults = %+v", created)
+	}
+
+	fetched, err := client.ChatV2.FetchChannel(*svc.Sid, "announcements")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *fetched.Sid != *created.Sid {
+		t.Errorf("FetchChannel by unique name returned %s, want %s", *fetched.Sid, *created.Sid)
+	}
+
+	if _, err := client.ChatV2.CreateChannel(*svc.Sid, (&openapi.CreateChannelParams{}).SetUniqueName("announcements")); err == nil {
+		t.Errorf("CreateChannel with a taken unique name succeeded")
+	}
+	if _, err := client.ChatV2.UpdateChannel(*svc.Sid, *created.Sid, (&openapi.UpdateChannelParams{}).SetAttributes("{")); err == nil {
+		t.Errorf("UpdateChannel with invalid attributes succeeded")
+	}
+
+	if err := client.ChatV2.DeleteChannel(*svc.Sid, *created.Sid, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ChatV2.FetchChannel(*svc.Sid, *created.Sid); !api.IsNotFound(err) {
+		t.Errorf("FetchChannel after delete: got %v, want a 404", err)
+	}
+}
+
+func TestServer_User(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	client := newRestClient(t, server)
+
+	svc, err := client.ChatV2.CreateService((&openapi.CreateServiceParams{}).SetFriendlyName("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := client.ChatV2.CreateUser(*svc.Sid, (&openapi.CreateUserParams{}).SetIdentity("bot"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *created.RoleSid != *svc.DefaultServiceRoleSid {
+		t.Errorf("role_sid = %s, want the default service role %s", *created.RoleSid, *svc.DefaultServiceRoleSid)
+	}
+
+	if _, err := client.ChatV2.FetchUser(*svc.Sid, "bot"); err != nil {
+		t.Errorf("FetchUser by identity: %v", err)
+	}
+	if _, err := client.ChatV2.CreateUser(*svc.Sid, (&openapi.CreateUserParams{}).SetIdentity("bot")); err == nil {
+		t.Errorf("CreateUser with a taken identity succeeded")
+	}
+	if _, err := client.ChatV2.UpdateUser(*svc.Sid, *created.Sid, (&openapi.UpdateUserParams{}).SetRoleSid(*svc.DefaultChannelRoleSid)); err == nil {
+		t.Errorf("UpdateUser with a channel role succeeded")
+	}
+
+	if err := client.ChatV2.DeleteUser(*svc.Sid, *created.Sid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ChatV2.FetchUser(*svc.Sid, *created.Sid); !api.IsNotFound(err) {
+		t.Errorf("FetchUser after delete: got %v, want a 404", err)
+	}
+}
+
+func TestServer_Member(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	client := newRestClient(t, server)
+
+	svc, err := client.ChatV2.CreateService((&openapi.CreateServiceParams{}).SetFriendlyName("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	channel, err := client.ChatV2.CreateChannel(*svc.Sid, (&openapi.CreateChannelParams{}).SetUniqueName("support"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := client.ChatV2.CreateMember(*svc.Sid, *channel.Sid, (&openapi.CreateMemberParams{}).SetIdentity("bot"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *created.RoleSid != *svc.DefaultChannelRoleSid {
+		t.Errorf("role_sid = %s, want the default channel role %s", *created.RoleSid, *svc.DefaultChannelRoleSid)
+	}
+
+	user, err := client.ChatV2.FetchUser(*svc.Sid, "bot")
+	if err != nil {
+		t.Fatalf("FetchUser of a new member: %v", err)
+	}
+	if *user.JoinedChannelsCount != 1 {
+		t.Errorf("joined_channels_count = %d, want 1", *user.JoinedChannelsCount)
+	}
+
+	if _, err := client.ChatV2.FetchMember(*svc.Sid, *channel.Sid, "bot"); err != nil {
+		t.Errorf("FetchMember by identity: %v", err)
+	}
+	if _, err := client.ChatV2.CreateMember(*svc.Sid, *channel.Sid, (&openapi.CreateMemberParams{}).SetIdentity("bot")); err == nil {
+		t.Errorf("CreateMember with an existing member succeeded")
+	}
+	if _, err := client.ChatV2.UpdateMember(*svc.Sid, *channel.Sid, *created.Sid, (&openapi.UpdateMemberParams{}).SetRoleSid(*svc.DefaultServiceRoleSid)); err == nil {
+		t.Errorf("UpdateMember with a deployment role succeeded")
+	}
+
+	if err := client.ChatV2.DeleteMember(*svc.Sid, *channel.Sid, *created.Sid, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ChatV2.FetchMember(*svc.Sid, *channel.Sid, *created.Sid); !api.IsNotFound(err) {
+		t.Errorf("FetchMember after delete: got %v, want a 404", err)
+	}
+	fetched, err := client.ChatV2.FetchChannel(*svc.Sid, *channel.Sid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *fetched.MembersCount != 0 {
+		t.Errorf("members_count = %d, want 0", *fetched.MembersCount)
+	}
+}
+
+func TestServer_ChannelWebhook(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	client := newRestClient(t, server)
+
+	svc, err := client.ChatV2.CreateService((&openapi.CreateServiceParams{}).SetFriendlyName("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	channel, err := client.ChatV2.CreateChannel(*svc.Sid, (&openapi.CreateChannelParams{}).SetUniqueName("support"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := client.ChatV2.CreateChannelWebhook(*svc.Sid, *channel.Sid, (&openapi.CreateChannelWebhookParams{}).
+		SetType("webhook").
+		SetConfigurationUrl("https://example.com/hook").
+		SetConfigurationFilters([]string{"onMessageSent"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if configuration := *created.Configuration; configuration["method"] != "POST" || configuration["url"] != "https://example.com/hook" {
+		t.Errorf("configuration = %v", configuration)
+	}
+
+	if _, err := client.ChatV2.CreateChannelWebhook(*svc.Sid, *channel.Sid, (&openapi.CreateChannelWebhookParams{}).SetType("studio")); err == nil {
+		t.Errorf("CreateChannelWebhook of a studio webhook without a flow succeeded")
+	}
+	if _, err := client.ChatV2.UpdateChannelWebhook(*svc.Sid, *channel.Sid, *created.Sid, (&openapi.UpdateChannelWebhookParams{}).SetConfigurationFilters([]string{"onSomething"})); err == nil {
+		t.Errorf("UpdateChannelWebhook with an unknown filter succeeded")
+	}
+
+	updated, err := client.ChatV2.UpdateChannelWebhook(*svc.Sid, *channel.Sid, *created.Sid, (&openapi.UpdateChannelWebhookParams{}).SetConfigurationRetryCount(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if configuration := *updated.Configuration; configuration["retry_count"] != float64(2) || configuration["url"] != "https://example.com/hook" {
+		t.Errorf("configuration after update = %v", configuration)
+	}
+
+	if err := client.ChatV2.DeleteChannel(*svc.Sid, *channel.Sid, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := server.ChannelWebhook(*svc.Sid, *channel.Sid, *created.Sid); ok {
+		t.Errorf("channel webhook survived the deletion of its channel")
+	}
+}
@@ -0,0 +1,328 @@
+package chattest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+// defaultRoles are the roles Twilio generates for every new service.
+var defaultRoles = []struct {
+	friendlyName string
+	roleType     string
+	permissions  []string
+}{
+	{"service admin", "deployment", []string{"addMember", "createChannel", "deleteAnyMessage", "destroyChannel", "editAnyMessage", "editAnyMessageAttributes", "editAnyUserInfo", "editChannelAttributes", "editChannelName", "editOwnMessage", "editOwnMessageAttributes", "editOwnUserInfo", "inviteMember", "joinChannel", "removeMember"}},
+	{"service user", "deployment", []string{"createChannel", "destroyChannel", "editOwnMessage", "editOwnMessageAttributes", "editOwnUserInfo", "joinChannel"}},
+	{"channel admin", "channel", []string{"addMember", "deleteAnyMessage", "deleteOwnMessage", "destroyChannel", "editAnyMessage", "editAnyMessageAttributes", "editChannelAttributes", "editChannelName", "editOwnMessage", "editOwnMessageAttributes", "editOwnUserInfo", "inviteMember", "leaveChannel", "removeMember", "sendMediaMessage", "sendMessage"}},
+	{"channel user", "channel", []string{"deleteOwnMessage", "editOwnMessage", "editOwnMessageAttributes", "editOwnUserInfo", "leaveChannel", "sendMediaMessage", "sendMessage"}},
+}
+
+// webhookEvents are the events Twilio accepts as webhook filters, in the order it reports them.
+var webhookEvents = []string{
+	"onMessageSend", "onMessageSent",
+	"onMessageUpdate", "onMessageUpdated",
+	"onMessageRemove", "onMessageRemoved",
+	"onMediaMessageSend", "onMediaMessageSent",
+	"onChannelAdd", "onChannelAdded",
+	"onChannelUpdate", "onChannelUpdated",
+	"onChannelDestroy", "onChannelDestroyed",
+	"onMemberAdd", "onMemberAdded",
+	"onMemberUpdate", "onMemberUpdated",
+	"onMemberRemove", "onMemberRemoved",
+	"onUserAdded",
+	"onUserUpdate", "onUserUpdated",
+}
+
+// notificationTemplates maps the form names of the notification templates to their response keys.
+var notificationTemplates = map[string]string{
+	"NewMessage":         "new_message",
+	"AddedToChannel":     "added_to_channel",
+	"RemovedFromChannel": "removed_from_channel",
+	"InvitedToChannel":   "invited_to_channel",
+}
+
+func (s *Server) serveServices(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.listServices(w, r)
+	case http.MethodPost:
+		s.createService(w, r)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveService(w http.ResponseWriter, r *http.Request, sid string) {
+	svc, ok := s.services[sid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, svc.ChatV2Service)
+	case http.MethodPost:
+		s.updateService(w, r, svc)
+	case http.MethodDelete:
+		delete(s.services, sid)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) listServices(w http.ResponseWriter, r *http.Request) {
+	sids := []string{}
+	for sid := range s.services {
+		sids = append(sids, sid)
+	}
+	sort.Strings(sids)
+
+	services := []interface{}{}
+	for _, sid := range sids {
+		services = append(services, s.services[sid].ChatV2Service)
+	}
+	s.writePage(w, r, "services", services)
+}
+
+func (s *Server) createService(w http.ResponseWriter, r *http.Request) {
+	friendlyName := r.PostForm.Get("FriendlyName")
+	if friendlyName == "" {
+		writeError(w, http.StatusBadRequest, 20001, "Missing required parameter FriendlyName in the post body")
+		return
+	}
+
+	sid := s.newSid("IS")
+	created := now()
+	svc := &service{
+		roles:    map[string]*openapi.ChatV2ServiceRole{},
+		channels: map[string]*openapi.ChatV2ServiceChannel{},
+		users:    map[string]*openapi.ChatV2ServiceUser{},
+		members:  map[string]map[string]*openapi.ChatV2ServiceChannelMember{},
+		webhooks: map[string]map[string]*openapi.ChatV2ServiceChannelChannelWebhook{},
+	}
+
+	roleSids := map[string]string{}
+	for _, role := range defaultRoles {
+		roleSid := s.newSid("RL")
+		friendlyName, roleType, permissions := role.friendlyName, role.roleType, append([]string{}, role.permissions...)
+		svc.roles[roleSid] = &openapi.ChatV2ServiceRole{
+			AccountSid:   stringPtr(AccountSid),
+			DateCreated:  created,
+			DateUpdated:  created,
+			FriendlyName: &friendlyName,
+			Permissions:  &permissions,
+			ServiceSid:   &sid,
+			Sid:          &roleSid,
+			Type:         &roleType,
+			Url:          stringPtr(fmt.Sprintf("%s/v2/Services/%s/Roles/%s", s.URL, sid, roleSid)),
+		}
+		roleSids[friendlyName] = roleSid
+	}
+
+	svc.ChatV2Service = openapi.ChatV2Service{
+		AccountSid:                   stringPtr(AccountSid),
+		ConsumptionReportInterval:    intPtr(10),
+		DateCreated:                  created,
+		DateUpdated:                  created,
+		DefaultChannelCreatorRoleSid: stringPtr(roleSids["channel admin"]),
+		DefaultChannelRoleSid:        stringPtr(roleSids["channel user"]),
+		DefaultServiceRoleSid:        stringPtr(roleSids["service user"]),
+		FriendlyName:                 &friendlyName,
+		Limits: &map[string]interface{}{
+			"channel_members": 100,
+			"user_channels":   250,
+		},
+		Notifications: &map[string]interface{}{
+			"log_enabled":          false,
+			"new_message":          map[string]interface{}{"enabled": false},
+			"added_to_channel":     map[string]interface{}{"enabled": false},
+			"removed_from_channel": map[string]interface{}{"enabled": false},
+			"invited_to_channel":   map[string]interface{}{"enabled": false},
+		},
+		PostWebhookRetryCount:  intPtr(0),
+		PreWebhookRetryCount:   intPtr(0),
+		ReachabilityEnabled:    boolPtr(false),
+		ReadStatusEnabled:      boolPtr(true),
+		Sid:                    &sid,
+		TypingIndicatorTimeout: intPtr(5),
+		Url:                    stringPtr(fmt.Sprintf("%s/v2/Services/%s", s.URL, sid)),
+		WebhookFilters:         &[]string{},
+		WebhookMethod:          stringPtr("POST"),
+	}
+
+	s.services[sid] = svc
+	writeJSON(w, http.StatusCreated, svc.ChatV2Service)
+}
+
+func (s *Server) updateService(w http.ResponseWriter, r *http.Request, svc *service) {
+	// Apply the parameters to a copy, so that a rejected update changes nothing.
+	updated := copyService(svc.ChatV2Service)
+	form := r.PostForm
+
+	if name, ok := invalidServiceParameter(form, svc); ok {
+		writeInvalidParameter(w, name)
+		return
+	}
+
+	for key, values := range form {
+		value := values[0]
+
+		switch key {
+		case "FriendlyName":
+			updated.FriendlyName = &value
+		case "DefaultServiceRoleSid":
+			updated.DefaultServiceRoleSid = &value
+		case "DefaultChannelRoleSid":
+			updated.DefaultChannelRoleSid = &value
+		case "DefaultChannelCreatorRoleSid":
+			updated.DefaultChannelCreatorRoleSid = &value
+		case "Limits.ChannelMembers":
+			(*updated.Limits)["channel_members"] = mustAtoi(value)
+		case "Limits.UserChannels":
+			(*updated.Limits)["user_channels"] = mustAtoi(value)
+		case "ReachabilityEnabled":
+			updated.ReachabilityEnabled = boolPtr(value == "true")
+		case "ReadStatusEnabled":
+			updated.ReadStatusEnabled = boolPtr(value == "true")
+		case "ConsumptionReportInterval":
+			updated.ConsumptionReportInterval = intPtr(mustAtoi(value))
+		case "TypingIndicatorTimeout":
+			updated.TypingIndicatorTimeout = intPtr(mustAtoi(value))
+		case "PreWebhookRetryCount":
+			updated.PreWebhookRetryCount = intPtr(mustAtoi(value))
+		case "PostWebhookRetryCount":
+			updated.PostWebhookRetryCount = intPtr(mustAtoi(value))
+		case "PreWebhookUrl":
+			updated.PreWebhookUrl = &value
+		case "PostWebhookUrl":
+			updated.PostWebhookUrl = &value
+		case "WebhookMethod":
+			updated.WebhookMethod = &value
+		case "WebhookFilters":
+			filters := []string{}
+			for _, event := range webhookEvents {
+				if containsString(values, event) {
+					filters = append(filters, event)
+				}
+			}
+			updated.WebhookFilters = &filters
+		case "Notifications.LogEnabled":
+			(*updated.Notifications)["log_enabled"] = value == "true"
+		default:
+			if !strings.HasPrefix(key, "Notifications.") {
+				continue
+			}
+			pieces := strings.Split(key, ".")
+			template, ok := notificationTemplates[pieces[1]]
+			if !ok || len(pieces) != 3 {
+				continue
+			}
+			settings := (*updated.Notifications)[template].(map[string]interface{})
+			switch pieces[2] {
+			case "Enabled":
+				settings["enabled"] = value == "true"
+			case "BadgeCountEnabled":
+				settings["badge_count_enabled"] = value == "true"
+			case "Template":
+				settings["template"] = value
+			case "Sound":
+				settings["sound"] = value
+			}
+		}
+	}
+
+	updated.DateUpdated = now()
+	svc.ChatV2Service = updated
+	writeJSON(w, http.StatusOK, svc.ChatV2Service)
+}
+
+// invalidServiceParameter returns the first parameter Twilio would reject.
+func invalidServiceParameter(form url.Values, svc *service) (string, bool) {
+	roleTypes := map[string]string{
+		"DefaultServiceRoleSid":        "deployment",
+		"DefaultChannelRoleSid":        "channel",
+		"DefaultChannelCreatorRoleSid": "channel",
+	}
+	for name, roleType := range roleTypes {
+		if v, ok := form[name]; ok {
+			role, ok := svc.roles[v[0]]
+			if !ok || *role.Type != roleType {
+				return name, true
+			}
+		}
+	}
+
+	for _, name := range []string{"PreWebhookUrl", "PostWebhookUrl"} {
+		if v := form.Get(name); v != "" {
+			u, err := url.ParseRequestURI(v)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return name, true
+			}
+		}
+	}
+
+	for _, event := range form["WebhookFilters"] {
+		// A single empty value clears the filters.
+		if event != "" && !containsString(webhookEvents, event) {
+			return "WebhookFilters", true
+		}
+	}
+
+	if v, ok := form["WebhookMethod"]; ok && v[0] != "GET" && v[0] != "POST" {
+		return "WebhookMethod", true
+	}
+
+	ranges := map[string][2]int{
+		"Limits.ChannelMembers":     {1, 1000},
+		"Limits.UserChannels":       {1, 1000},
+		"PreWebhookRetryCount":      {0, 3},
+		"PostWebhookRetryCount":     {0, 3},
+		"ConsumptionReportInterval": {0, 1 << 30},
+		"TypingIndicatorTimeout":    {0, 1 << 30},
+	}
+	for name, bounds := range ranges {
+		if v, ok := form[name]; ok {
+			n, err := strconv.Atoi(v[0])
+			if err != nil || n < bounds[0] || n > bounds[1] {
+				return name, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// copyService returns a deep copy of a service, including its nested maps.
+func copyService(cs openapi.ChatV2Service) openapi.ChatV2Service {
+	b, _ := json.Marshal(cs)
+	copied := openapi.ChatV2Service{}
+	json.Unmarshal(b, &copied)
+	return copied
+}
+
+func mustAtoi(v string) int {
+	n, _ := strconv.Atoi(v)
+	return n
+}
+
+func containsString(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func stringPtr(v string) *string { return &v }
+func intPtr(v int) *int          { return &v }
+func boolPtr(v bool) *bool       { return &v }
@@ -0,0 +1,375 @@
+package chattest
+
+import (
+	"time"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+// Service returns a copy of the service sid as the API would return it.
+func (s *Server) Service(sid string) (openapi.ChatV2Service, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[sid]
+	if !ok {
+		return openapi.ChatV2Service{}, false
+	}
+	return copyService(svc.ChatV2Service), true
+}
+
+// UpdateService changes the service sid out of band, like an edit in the Twilio console.
+func (s *Server) UpdateService(sid string, update func(cs *openapi.ChatV2Service)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[sid]
+	if !ok {
+		return false
+	}
+	update(&svc.ChatV2Service)
+	svc.DateUpdated = now()
+	return true
+}
+
+// DeleteService removes the service sid out of band.
+func (s *Server) DeleteService(sid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.services[sid]
+	delete(s.services, sid)
+	return ok
+}
+
+// RoleSid returns the SID of the role named friendlyName in the service serviceSid.
+func (s *Server) RoleSid(serviceSid string, friendlyName string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return "", false
+	}
+	for sid, role := range svc.roles {
+		if *role.FriendlyName == friendlyName {
+			return sid, true
+		}
+	}
+	return "", false
+}
+
+// Role returns a copy of the role sid of the service serviceSid as the API would return it.
+func (s *Server) Role(serviceSid string, sid string) (openapi.ChatV2ServiceRole, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return openapi.ChatV2ServiceRole{}, false
+	}
+	role, ok := svc.roles[sid]
+	if !ok {
+		return openapi.ChatV2ServiceRole{}, false
+	}
+	granted := append([]string{}, *role.Permissions...)
+	copied := *role
+	copied.Permissions = &granted
+	return copied, true
+}
+
+// UpdateRole changes the role sid of the service serviceSid out of band.
+func (s *Server) UpdateRole(serviceSid string, sid string, update func(role *openapi.ChatV2ServiceRole)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return false
+	}
+	role, ok := svc.roles[sid]
+	if !ok {
+		return false
+	}
+	update(role)
+	role.DateUpdated = now()
+	return true
+}
+
+// DeleteRole removes the role sid of the service serviceSid out of band.
+func (s *Server) DeleteRole(serviceSid string, sid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return false
+	}
+	_, ok = svc.roles[sid]
+	delete(svc.roles, sid)
+	return ok
+}
+
+// Channel returns a copy of the channel sid of the service serviceSid as the API would return it.
+func (s *Server) Channel(serviceSid string, sid string) (openapi.ChatV2ServiceChannel, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return openapi.ChatV2ServiceChannel{}, false
+	}
+	channel, ok := svc.channel(sid)
+	if !ok {
+		return openapi.ChatV2ServiceChannel{}, false
+	}
+	return *channel, true
+}
+
+// UpdateChannel changes the channel sid of the service serviceSid out of band.
+func (s *Server) UpdateChannel(serviceSid string, sid string, update func(channel *openapi.ChatV2ServiceChannel)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return false
+	}
+	channel, ok := svc.channel(sid)
+	if !ok {
+		return false
+	}
+	update(channel)
+	channel.DateUpdated = now()
+	return true
+}
+
+// DeleteChannel removes the channel sid of the service serviceSid out of band.
+func (s *Server) DeleteChannel(serviceSid string, sid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return false
+	}
+	channel, ok := svc.channel(sid)
+	if !ok {
+		return false
+	}
+	svc.deleteChannel(*channel.Sid)
+	return true
+}
+
+// Member returns a copy of the member sid of the channel channelSid as the API would return it.
+func (s *Server) Member(serviceSid string, channelSid string, sid string) (openapi.ChatV2ServiceChannelMember, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return openapi.ChatV2ServiceChannelMember{}, false
+	}
+	member, ok := svc.member(channelSid, sid)
+	if !ok {
+		return openapi.ChatV2ServiceChannelMember{}, false
+	}
+	return *member, true
+}
+
+// DeleteMember removes the member sid from the channel channelSid out of band, like a member leaving the channel.
+func (s *Server) DeleteMember(serviceSid string, channelSid string, sid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return false
+	}
+	member, ok := svc.member(channelSid, sid)
+	if !ok {
+		return false
+	}
+	svc.deleteMember(channelSid, *member.Sid)
+	return true
+}
+
+// ChannelWebhook returns a copy of the webhook sid of the channel channelSid as the API would return it.
+func (s *Server) ChannelWebhook(serviceSid string, channelSid string, sid string) (openapi.ChatV2ServiceChannelChannelWebhook, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return openapi.ChatV2ServiceChannelChannelWebhook{}, false
+	}
+	webhook, ok := svc.webhooks[channelSid][sid]
+	if !ok {
+		return openapi.ChatV2ServiceChannelChannelWebhook{}, false
+	}
+	return *webhook, true
+}
+
+// DeleteChannelWebhook deletes the webhook sid of the channel channelSid out of band.
+func (s *Server) DeleteChannelWebhook(serviceSid string, channelSid string, sid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return false
+	}
+	if _, ok := svc.webhooks[channelSid][sid]; !ok {
+		return false
+	}
+	delete(svc.webhooks[channelSid], sid)
+	return true
+}
+
+// User returns a copy of the user sid of the service serviceSid as the API would return it.
+func (s *Server) User(serviceSid string, sid string) (openapi.ChatV2ServiceUser, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return openapi.ChatV2ServiceUser{}, false
+	}
+	user, ok := svc.user(sid)
+	if !ok {
+		return openapi.ChatV2ServiceUser{}, false
+	}
+	return *user, true
+}
+
+// UpdateUser changes the user sid of the service serviceSid out of band.
+func (s *Server) UpdateUser(serviceSid string, sid string, update func(user *openapi.ChatV2ServiceUser)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return false
+	}
+	user, ok := svc.user(sid)
+	if !ok {
+		return false
+	}
+	update(user)
+	user.DateUpdated = now()
+	return true
+}
+
+// DeleteUser removes the user sid of the service serviceSid out of band.
+func (s *Server) DeleteUser(serviceSid string, sid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		return false
+	}
+	user, ok := svc.user(sid)
+	if !ok {
+		return false
+	}
+	svc.deleteUser(*user.Sid)
+	return true
+}
+
+// Credential returns the credential sid as the API would return it.
+func (s *Server) Credential(sid string) (openapi.ChatV2Credential, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cred, ok := s.credentials[sid]
+	if !ok {
+		return openapi.ChatV2Credential{}, false
+	}
+	return cred.ChatV2Credential, true
+}
+
+// CredentialSecret returns the FCM secret of the credential sid, which the API never returns.
+func (s *Server) CredentialSecret(sid string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cred, ok := s.credentials[sid]; ok {
+		return cred.secret
+	}
+	return ""
+}
+
+// CredentialSecretWrites returns the number of requests that set the FCM secret of the credential sid.
+func (s *Server) CredentialSecretWrites(sid string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cred, ok := s.credentials[sid]; ok {
+		return cred.secretWrites
+	}
+	return 0
+}
+
+// ReplaceCredentialSecret replaces the FCM secret of the credential sid out of
+// band, like a change in the Twilio console, and moves its date_updated forward.
+func (s *Server) ReplaceCredentialSecret(sid string, secret string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cred, ok := s.credentials[sid]
+	if !ok {
+		return false
+	}
+	cred.secret = secret
+	updated := now()
+	if !updated.After(*cred.DateUpdated) {
+		*updated = cred.DateUpdated.Add(time.Second)
+	}
+	cred.DateUpdated = updated
+	return true
+}
+
+// CredentialAPIKey returns the GCM API key of the credential sid, which the API never returns.
+func (s *Server) CredentialAPIKey(sid string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cred, ok := s.credentials[sid]; ok {
+		return cred.apiKey
+	}
+	return ""
+}
+
+// CredentialCertificate returns the APNs certificate and private key of the credential sid, which the API never returns.
+func (s *Server) CredentialCertificate(sid string) (certificate string, privateKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cred, ok := s.credentials[sid]; ok {
+		return cred.certificate, cred.privateKey
+	}
+	return "", ""
+}
+
+// DeleteCredential removes the credential sid out of band.
+func (s *Server) DeleteCredential(sid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.credentials[sid]
+	delete(s.credentials, sid)
+	return ok
+}
+
+// ServiceSids returns the SIDs of every service.
+func (s *Server) ServiceSids() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sids := []string{}
+	for sid := range s.services {
+		sids = append(sids, sid)
+	}
+	return sids
+}
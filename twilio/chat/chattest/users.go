@@ -0,0 +1,160 @@
+package chattest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+func (s *Server) serveUsers(w http.ResponseWriter, r *http.Request, serviceSid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		s.createUser(w, r, svc)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveUser(w http.ResponseWriter, r *http.Request, serviceSid string, sid string) {
+	svc, ok := s.services[serviceSid]
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+	user, ok := svc.user(sid)
+	if !ok {
+		writeNotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, user)
+	case http.MethodPost:
+		updateUser(w, r, svc, user)
+	case http.MethodDelete:
+		svc.deleteUser(*user.Sid)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// user looks a user up by SID or identity, like the API.
+func (svc *service) user(sidOrIdentity string) (*openapi.ChatV2ServiceUser, bool) {
+	if user, ok := svc.users[sidOrIdentity]; ok {
+		return user, true
+	}
+	for _, user := range svc.users {
+		if *user.Identity == sidOrIdentity {
+			return user, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request, svc *service) {
+	form := r.PostForm
+
+	identity := form.Get("Identity")
+	if identity == "" {
+		writeError(w, http.StatusBadRequest, 20001, "Missing required parameter Identity in the post body")
+		return
+	}
+	if _, ok := svc.user(identity); ok {
+		writeError(w, http.StatusConflict, 50201, "User with provided identity already exists")
+		return
+	}
+	if name, ok := invalidUserParameter(form, svc); ok {
+		writeInvalidParameter(w, name)
+		return
+	}
+
+	sid := s.newSid("US")
+	created := now()
+	user := &openapi.ChatV2ServiceUser{
+		AccountSid:          stringPtr(AccountSid),
+		Attributes:          stringPtr("{}"),
+		DateCreated:         created,
+		DateUpdated:         created,
+		FriendlyName:        stringPtr(form.Get("FriendlyName")),
+		Identity:            &identity,
+		IsNotifiable:        boolPtr(false),
+		IsOnline:            boolPtr(false),
+		JoinedChannelsCount: intPtr(0),
+		RoleSid:             svc.DefaultServiceRoleSid,
+		ServiceSid:          svc.Sid,
+		Sid:                 &sid,
+		Url:                 stringPtr(fmt.Sprintf("%s/v2/Services/%s/Users/%s", s.URL, *svc.Sid, sid)),
+	}
+	if v := form.Get("Attributes"); v != "" {
+		user.Attributes = &v
+	}
+	if v := form.Get("RoleSid"); v != "" {
+		user.RoleSid = &v
+	}
+
+	svc.users[sid] = user
+	writeJSON(w, http.StatusCreated, user)
+}
+
+func updateUser(w http.ResponseWriter, r *http.Request, svc *service, user *openapi.ChatV2ServiceUser) {
+	form := r.PostForm
+
+	if name, ok := invalidUserParameter(form, svc); ok {
+		writeInvalidParameter(w, name)
+		return
+	}
+
+	if v, ok := form["FriendlyName"]; ok {
+		user.FriendlyName = &v[0]
+	}
+	if v, ok := form["Attributes"]; ok {
+		user.Attributes = &v[0]
+	}
+	if v := form.Get("RoleSid"); v != "" {
+		user.RoleSid = &v
+	}
+
+	user.DateUpdated = now()
+	writeJSON(w, http.StatusOK, user)
+}
+
+// invalidUserParameter checks that the role of a user is a deployment role of the service and that its attributes are JSON.
+func invalidUserParameter(form url.Values, svc *service) (string, bool) {
+	if v, ok := form["RoleSid"]; ok && v[0] != "" {
+		role, ok := svc.roles[v[0]]
+		if !ok || *role.Type != "deployment" {
+			return "RoleSid", true
+		}
+	}
+	if v, ok := form["Attributes"]; ok && v[0] != "" && !json.Valid([]byte(v[0])) {
+		return "Attributes", true
+	}
+	return "", false
+}
+
+// deleteUser deletes the user sid and removes it from the channels it joined.
+func (svc *service) deleteUser(sid string) {
+	user, ok := svc.users[sid]
+	if !ok {
+		return
+	}
+	for channelSid, members := range svc.members {
+		for memberSid, member := range members {
+			if *member.Identity == *user.Identity {
+				svc.deleteMember(channelSid, memberSid)
+			}
+		}
+	}
+	delete(svc.users, sid)
+}
@@ -0,0 +1,13 @@
+package chat
+
+import (
+	"terraform-provider-twilio/twilio/chat/datasource/roles"
+	"terraform-provider-twilio/twilio/chat/datasource/service"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var DataSourcesMap = map[string]*schema.Resource{
+	"twilio_chat_roles":   roles.DataSourceRoles(),
+	"twilio_chat_service": service.DataSourceService(),
+}
@@ -0,0 +1,87 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serviceSid := d.Get("service_sid").(string)
+	roleType := d.Get("type").(string)
+
+	res, err := m.(*api.Client).ListRoles(ctx, serviceSid)
+	if err != nil {
+		return api.DiagFromErr(err, nil)
+	}
+
+	roles := []map[string]interface{}{}
+	sids := map[string]interface{}{}
+	shared := map[string]bool{}
+	for _, role := range res {
+		var sid, friendlyName, t string
+		if role.Sid != nil {
+			sid = *role.Sid
+		}
+		if role.FriendlyName != nil {
+			friendlyName = *role.FriendlyName
+		}
+		if role.Type != nil {
+			t = *role.Type
+		}
+		if roleType != "" && t != roleType {
+			continue
+		}
+
+		permissions := []string{}
+		if role.Permissions != nil {
+			permissions = *role.Permissions
+		}
+		roles = append(roles, map[string]interface{}{
+			"sid":           sid,
+			"friendly_name": friendlyName,
+			"type":          t,
+			"permissions":   permissions,
+		})
+
+		if friendlyName == "" {
+			continue
+		}
+		if _, ok := sids[friendlyName]; ok {
+			shared[friendlyName] = true
+		}
+		sids[friendlyName] = sid
+	}
+
+	sort.SliceStable(roles, func(i, j int) bool {
+		return roles[i]["friendly_name"].(string) < roles[j]["friendly_name"].(string)
+	})
+
+	// A name shared by several roles cannot identify one of them.
+	for name := range shared {
+		delete(sids, name)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Several roles of chat service %s are named %q", serviceSid, name),
+			Detail:   "They are listed in roles but left out of sids.",
+		})
+	}
+
+	if err := d.Set("roles", roles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sids", sids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceSid, roleType))
+
+	return diags
+}
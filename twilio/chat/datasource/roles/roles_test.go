@@ -0,0 +1,88 @@
+package roles_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const dataSourceName = "data.twilio_chat_roles.test"
+
+func testAccCheckRoleSid(server *chattest.Server, friendlyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("data source %s not found", dataSourceName)
+		}
+		want, ok := server.RoleSid(rs.Primary.Attributes["service_sid"], friendlyName)
+		if !ok {
+			return fmt.Errorf("role %q does not exist", friendlyName)
+		}
+		return resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("sids.%s", friendlyName), want)(s)
+	}
+}
+
+func testAccRolesConfig(server *chattest.Server, roleType string) string {
+	typeArgument := "null"
+	if roleType != "" {
+		typeArgument = fmt.Sprintf("%q", roleType)
+	}
+
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "roles"
+}
+
+resource "twilio_chat_role" "test" {
+  service_sid   = twilio_chat_service.test.id
+  friendly_name = "moderator"
+  type          = "channel"
+  permissions   = ["sendMessage", "removeMember"]
+}
+
+data "twilio_chat_roles" "test" {
+  service_sid = twilio_chat_role.test.service_sid
+  type        = %s
+}
+`, typeArgument)
+}
+
+func TestAccChatRolesDataSource_basic(t *testing.T) {
+	server := chattest.NewServer()
+	server.MaxPageSize = 2
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolesConfig(server, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.0.friendly_name", "channel admin"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.2.friendly_name", "moderator"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.2.permissions.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "roles.2.sid", "twilio_chat_role.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "sids.%", "5"),
+					testAccCheckRoleSid(server, "service admin"),
+					testAccCheckRoleSid(server, "service user"),
+					testAccCheckRoleSid(server, "channel admin"),
+					testAccCheckRoleSid(server, "channel user"),
+				),
+			},
+			{
+				Config: testAccRolesConfig(server, "deployment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.0.type", "deployment"),
+					resource.TestCheckResourceAttr(dataSourceName, "sids.%", "2"),
+					testAccCheckRoleSid(server, "service user"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/resource/service"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var res *openapi.ChatV2Service
+	var diags diag.Diagnostics
+
+	if sid := d.Get("sid").(string); sid != "" {
+		fetched, err := m.(*api.Client).Services(ctx).FetchService(sid)
+		if err != nil {
+			if api.IsNotFound(err) {
+				return diag.Diagnostics{{
+					Severity:      diag.Error,
+					Summary:       fmt.Sprintf("Chat service %s not found", sid),
+					AttributePath: cty.GetAttrPath("sid"),
+				}}
+			}
+			return api.DiagFromErr(err, nil)
+		}
+		res = fetched
+	} else {
+		found, findDiags := findService(ctx, m.(*api.Client), d.Get("friendly_name").(string))
+		if findDiags.HasError() {
+			return findDiags
+		}
+		res = found
+	}
+
+	if err := d.Set("sid", res.Sid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := service.SetAttributes(d, m, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*res.Sid)
+
+	return diags
+}
+
+// findService returns the only Chat service named friendlyName.
+func findService(ctx context.Context, client *api.Client, friendlyName string) (*openapi.ChatV2Service, diag.Diagnostics) {
+	services, err := client.ListServices(ctx)
+	if err != nil {
+		return nil, api.DiagFromErr(err, nil)
+	}
+
+	var matches []openapi.ChatV2Service
+	for _, svc := range services {
+		if *svc.FriendlyName == friendlyName {
+			matches = append(matches, svc)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return &matches[0], nil
+	case 0:
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("No chat service is named %q", friendlyName),
+			AttributePath: cty.GetAttrPath("friendly_name"),
+		}}
+	}
+
+	sids := []string{}
+	for _, svc := range matches {
+		sids = append(sids, *svc.Sid)
+	}
+	sort.Strings(sids)
+	return nil, diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("%d chat services are named %q", len(matches), friendlyName),
+		Detail:        fmt.Sprintf("Look the service up by sid instead, one of %s.", strings.Join(sids, ", ")),
+		AttributePath: cty.GetAttrPath("friendly_name"),
+	}}
+}
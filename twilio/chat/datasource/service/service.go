@@ -0,0 +1,55 @@
+package service
+
+import (
+	"time"
+
+	"terraform-provider-twilio/twilio/chat/resource/service"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var lookup = []string{"sid", "friendly_name"}
+
+func DataSourceService() *schema.Resource {
+	attributes := computed(service.ResourceCredentialService().Schema)
+	attributes["sid"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: lookup,
+	}
+	attributes["friendly_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: lookup,
+	}
+
+	return &schema.Resource{
+		ReadContext: readContext,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: attributes,
+	}
+}
+
+// computed returns the attributes of the resource schema as computed
+// attributes, so that the data source exposes exactly what the resource does.
+func computed(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{}
+	for name, attribute := range attributes {
+		s := &schema.Schema{
+			Type:     attribute.Type,
+			Computed: true,
+		}
+		switch elem := attribute.Elem.(type) {
+		case *schema.Resource:
+			s.Elem = &schema.Resource{Schema: computed(elem.Schema)}
+		case *schema.Schema:
+			s.Elem = &schema.Schema{Type: elem.Type}
+		}
+		result[name] = s
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const resourceName = "twilio_chat_service.test"
+
+func testAccServiceConfig(server *chattest.Server, lookup string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "owned-elsewhere"
+
+  limits {
+    channel_members = 50
+    user_channels   = 100
+  }
+
+  webhooks {
+    pre_hook_url = "https://example.com/pre"
+    events       = ["onMessageSend"]
+  }
+}
+
+resource "twilio_chat_service" "other" {
+  friendly_name = "other"
+}
+
+data "twilio_chat_service" "test" {
+  %s
+}
+`, lookup)
+}
+
+func TestAccChatServiceDataSource_basic(t *testing.T) {
+	server := chattest.NewServer()
+	server.MaxPageSize = 1
+	defer server.Close()
+
+	checks := func(dataSourceName string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+			resource.TestCheckResourceAttrPair(dataSourceName, "sid", resourceName, "id"),
+			resource.TestCheckResourceAttr(dataSourceName, "friendly_name", "owned-elsewhere"),
+			resource.TestCheckResourceAttrPair(dataSourceName, "date_created", resourceName, "date_created"),
+			resource.TestCheckResourceAttrPair(dataSourceName, "region", resourceName, "region"),
+			resource.TestCheckResourceAttrPair(dataSourceName, "roles.0.default_service_role", resourceName, "roles.0.default_service_role"),
+			resource.TestCheckResourceAttr(dataSourceName, "limits.0.channel_members", "50"),
+			resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.pre_hook_url", "https://example.com/pre"),
+			resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.events.#", "1"),
+			resource.TestCheckResourceAttrPair(dataSourceName, "additional_settings.0.typing_indicator_timeout", resourceName, "additional_settings.0.typing_indicator_timeout"),
+			resource.TestCheckResourceAttr(dataSourceName, "notifications.0.new_message.0.enabled", "false"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig(server, `sid = twilio_chat_service.test.id`),
+				Check:  checks("data.twilio_chat_service.test"),
+			},
+			{
+				Config: testAccServiceConfig(server, `friendly_name = twilio_chat_service.test.friendly_name`),
+				Check:  checks("data.twilio_chat_service.test"),
+			},
+		},
+	})
+}
+
+func TestAccChatServiceDataSource_invalid(t *testing.T) {
+	server := chattest.NewServer()
+	server.MaxPageSize = 1
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceConfig(server, ``),
+				ExpectError: regexp.MustCompile(`one of .friendly_name,sid. must be specified`),
+			},
+			{
+				Config: testAccServiceConfig(server, `sid = twilio_chat_service.test.id`) + `
+resource "twilio_chat_service" "twin" {
+  friendly_name = "owned-elsewhere"
+}
+
+data "twilio_chat_service" "ambiguous" {
+  friendly_name = twilio_chat_service.twin.friendly_name
+}
+`,
+				ExpectError: regexp.MustCompile(`2 chat services are named "owned-elsewhere"`),
+			},
+			{
+				Config:      testAccServiceConfig(server, `friendly_name = "missing"`),
+				ExpectError: regexp.MustCompile(`No chat service is named "missing"`),
+			},
+			{
+				Config:      testAccServiceConfig(server, `sid = "IS00000000000000000000000000000000"`),
+				ExpectError: regexp.MustCompile(`Chat service IS00000000000000000000000000000000 not found`),
+			},
+			{
+				Config: testAccServiceConfig(server, `sid = twilio_chat_service.test.id`),
+			},
+		},
+	})
+}
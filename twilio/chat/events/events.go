@@ -0,0 +1,45 @@
+// Package events lists the events Programmable Chat sends to webhooks, for
+// the service webhooks and for the webhooks of single channels alike.
+package events
+
+import "strings"
+
+// Supported lists the events Chat can send to webhooks. The onXxx events are
+// sent to the pre-event webhook before the action is applied, the matching
+// onXxxed events to the post-event webhook afterwards.
+var Supported = []string{
+	"onMessageSend",
+	"onMessageUpdate",
+	"onMessageRemove",
+	"onMediaMessageSend",
+	"onChannelAdd",
+	"onChannelUpdate",
+	"onChannelDestroy",
+	"onMemberAdd",
+	"onMemberUpdate",
+	"onMemberRemove",
+	"onUserUpdate",
+	"onMessageSent",
+	"onMessageUpdated",
+	"onMessageRemoved",
+	"onMediaMessageSent",
+	"onChannelAdded",
+	"onChannelUpdated",
+	"onChannelDestroyed",
+	"onMemberAdded",
+	"onMemberUpdated",
+	"onMemberRemoved",
+	"onUserAdded",
+	"onUserUpdated",
+}
+
+// Canonical returns the name of event as spelled in Supported.
+// Unknown events are returned unchanged.
+func Canonical(event string) string {
+	for _, e := range Supported {
+		if strings.EqualFold(e, event) {
+			return e
+		}
+	}
+	return event
+}
@@ -1,13 +1,27 @@
 package chat
 
 import (
+	"terraform-provider-twilio/twilio/chat/resource/channel"
+	"terraform-provider-twilio/twilio/chat/resource/channel/webhook"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+	"terraform-provider-twilio/twilio/chat/resource/credential/apn"
 	"terraform-provider-twilio/twilio/chat/resource/credential/fcm"
+	"terraform-provider-twilio/twilio/chat/resource/member"
+	"terraform-provider-twilio/twilio/chat/resource/role"
 	"terraform-provider-twilio/twilio/chat/resource/service"
+	"terraform-provider-twilio/twilio/chat/resource/user"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var ResourcesMap = map[string]*schema.Resource{
-	"twilio_chat_service":        service.ResourceCredentialService(),
-	"twilio_chat_fcm_credential": fcm.ResourceCredentialService(),
+	"twilio_chat_service":         service.ResourceCredentialService(),
+	"twilio_chat_credential":      credential.ResourceCredential(),
+	"twilio_chat_fcm_credential":  fcm.ResourceCredentialService(),
+	"twilio_chat_apn_credential":  apn.ResourceCredentialAPN(),
+	"twilio_chat_role":            role.ResourceRole(),
+	"twilio_chat_channel":         channel.ResourceChannel(),
+	"twilio_chat_user":            user.ResourceUser(),
+	"twilio_chat_channel_member":  member.ResourceChannelMember(),
+	"twilio_chat_channel_webhook": webhook.ResourceChannelWebhook(),
 }
@@ -0,0 +1,92 @@
+package channel
+
+import (
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// parameterPaths maps the parameters of the channel API to the attributes they are set from.
+var parameterPaths = api.ParameterPaths{
+	"FriendlyName": cty.GetAttrPath("friendly_name"),
+	"UniqueName":   cty.GetAttrPath("unique_name"),
+	"Type":         cty.GetAttrPath("type"),
+	"Attributes":   cty.GetAttrPath("attributes"),
+}
+
+func ResourceChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createContext,
+		ReadContext:   readContext,
+		UpdateContext: updateContext,
+		DeleteContext: deleteContext,
+		CustomizeDiff: api.CustomizeDiffRegion,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: api.ImportStateWithParents("service_sid"),
+		},
+		Schema: map[string]*schema.Schema{
+			"service_sid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"unique_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+			},
+			"attributes": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "{}",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: api.SuppressEquivalentJSON,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"members_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"messages_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": api.RegionSchema(),
+		},
+	}
+}
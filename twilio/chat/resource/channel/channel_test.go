@@ -0,0 +1,165 @@
+package channel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const resourceName = "twilio_chat_channel.test"
+
+func testAccCheckChannelSid(sid *string, replaced bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		got := s.RootModule().Resources[resourceName].Primary.ID
+		if replaced && got == *sid {
+			return fmt.Errorf("chat channel %s was not replaced", *sid)
+		}
+		if !replaced && got != *sid {
+			return fmt.Errorf("chat channel %s was replaced with %s", *sid, got)
+		}
+		*sid = got
+		return nil
+	}
+}
+
+func testAccChannelConfig(server *chattest.Server, friendlyName string, channelType string, attributes string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "channels"
+}
+
+resource "twilio_chat_channel" "test" {
+  service_sid   = twilio_chat_service.test.id
+  friendly_name = %q
+  unique_name   = "announcements"
+  type          = %q
+  attributes    = %s
+}
+`, friendlyName, channelType, attributes)
+}
+
+func TestAccChatChannel_basic(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig(server, "Announcements", "public", `jsonencode({ pinned = true, topic = "news" })`),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &sid),
+					resource.TestCheckResourceAttrPair(resourceName, "service_sid", "twilio_chat_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "Announcements"),
+					resource.TestCheckResourceAttr(resourceName, "unique_name", "announcements"),
+					resource.TestCheckResourceAttr(resourceName, "type", "public"),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"pinned":true,"topic":"news"}`),
+					resource.TestCheckResourceAttr(resourceName, "members_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "region", "us1"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				// The same attributes, formatted differently.
+				Config:   testAccChannelConfig(server, "Announcements", "public", "<<EOT\n{\n  \"topic\": \"news\",\n  \"pinned\": true\n}\nEOT"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccChannelConfig(server, "News", "public", `jsonencode({ pinned = false })`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelSid(&sid, false),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "News"),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"pinned":false}`),
+				),
+			},
+			{
+				Config: testAccChannelConfig(server, "News", "private", `jsonencode({ pinned = false })`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelSid(&sid, true),
+					resource.TestCheckResourceAttr(resourceName, "type", "private"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return serviceSid + "/" + sid, nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccChatChannel_drift(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, sid string
+	config := testAccChannelConfig(server, "Support", "public", `jsonencode({ pinned = true })`)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  server.CheckExists(resourceName, &serviceSid, &sid),
+			},
+			{
+				PreConfig: func() {
+					server.UpdateChannel(serviceSid, sid, func(channel *openapi.ChatV2ServiceChannel) {
+						attributes := `{"pinned": false}`
+						channel.Attributes = &attributes
+					})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(resourceName, "attributes", `{"pinned":true}`),
+			},
+		},
+	})
+}
+
+func TestAccChatChannel_disappears(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, sid string
+	config := testAccChannelConfig(server, "Support", "public", `"{}"`)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &sid),
+					func(*terraform.State) error {
+						server.DeleteChannel(serviceSid, sid)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  server.CheckExists(resourceName, &serviceSid, &sid),
+			},
+		},
+	})
+}
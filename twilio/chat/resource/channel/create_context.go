@@ -0,0 +1,36 @@
+package channel
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func createContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Channels(ctx)
+
+	params := &openapi.CreateChannelParams{}
+	params.SetType(d.Get("type").(string))
+	params.SetAttributes(d.Get("attributes").(string))
+
+	if v, ok := d.GetOk("friendly_name"); ok {
+		params.SetFriendlyName(v.(string))
+	}
+	if v, ok := d.GetOk("unique_name"); ok {
+		params.SetUniqueName(v.(string))
+	}
+
+	res, err := client.CreateChannel(d.Get("service_sid").(string), params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	d.SetId(*res.Sid)
+
+	return readContext(ctx, d, m)
+}
@@ -0,0 +1,27 @@
+package channel
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func deleteContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Channels(ctx)
+
+	var diags diag.Diagnostics
+
+	err := client.DeleteChannel(d.Get("service_sid").(string), d.Id(), &openapi.DeleteChannelParams{})
+	if err != nil && !api.IsNotFound(err) {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	d.SetId("")
+
+	return diags
+}
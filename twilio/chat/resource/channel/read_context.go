@@ -0,0 +1,67 @@
+package channel
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Channels(ctx)
+
+	var diags diag.Diagnostics
+
+	res, err := client.FetchChannel(d.Get("service_sid").(string), d.Id())
+	if err != nil {
+		if api.IsNotFound(err) {
+			log.Printf("[WARN] Chat channel %s not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	if err := d.Set("service_sid", res.ServiceSid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("friendly_name", res.FriendlyName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("unique_name", res.UniqueName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", res.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("attributes", res.Attributes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_by", res.CreatedBy); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("members_count", res.MembersCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("messages_count", res.MessagesCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := api.SetRegion(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", res.Url); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_created", res.DateCreated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"context"
+	"sort"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func createContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).ChannelWebhooks(ctx)
+
+	params := &openapi.CreateChannelWebhookParams{}
+	params.SetType(d.Get("type").(string))
+
+	if v, ok := d.GetOk("configuration.0.url"); ok {
+		params.SetConfigurationUrl(v.(string))
+	}
+	if v, ok := d.GetOk("configuration.0.method"); ok {
+		params.SetConfigurationMethod(v.(string))
+	}
+	if v, ok := d.GetOk("configuration.0.filters"); ok {
+		params.SetConfigurationFilters(stringsFromSet(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("configuration.0.triggers"); ok {
+		params.SetConfigurationTriggers(stringsFromSet(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("configuration.0.flow_sid"); ok {
+		params.SetConfigurationFlowSid(v.(string))
+	}
+	if v, ok := d.GetOk("configuration.0.retry_count"); ok {
+		params.SetConfigurationRetryCount(v.(int))
+	}
+
+	res, err := client.CreateChannelWebhook(d.Get("service_sid").(string), d.Get("channel_sid").(string), params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	d.SetId(*res.Sid)
+
+	return readContext(ctx, d, m)
+}
+
+// stringsFromSet returns the elements of set in a stable order.
+func stringsFromSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		values = append(values, v.(string))
+	}
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func deleteContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).ChannelWebhooks(ctx)
+
+	var diags diag.Diagnostics
+
+	err := client.DeleteChannelWebhook(d.Get("service_sid").(string), d.Get("channel_sid").(string), d.Id())
+	if err != nil && !api.IsNotFound(err) {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/events"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).ChannelWebhooks(ctx)
+
+	var diags diag.Diagnostics
+
+	res, err := client.FetchChannelWebhook(d.Get("service_sid").(string), d.Get("channel_sid").(string), d.Id())
+	if err != nil {
+		if api.IsNotFound(err) {
+			log.Printf("[WARN] Chat channel webhook %s not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	if err := d.Set("service_sid", res.ServiceSid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("channel_sid", res.ChannelSid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", res.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("configuration", configurationFromResponse(res)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := api.SetRegion(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", res.Url); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_created", res.DateCreated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func configurationFromResponse(res *openapi.ChatV2ServiceChannelChannelWebhook) []interface{} {
+	configuration := map[string]interface{}{}
+	if res.Configuration == nil {
+		return []interface{}{configuration}
+	}
+
+	for k, v := range *res.Configuration {
+		switch k {
+		case "url", "method", "flow_sid":
+			if s, ok := v.(string); ok {
+				configuration[k] = s
+			}
+		case "filters":
+			if values, ok := v.([]interface{}); ok {
+				filters := []interface{}{}
+				for _, e := range values {
+					filters = append(filters, events.Canonical(e.(string)))
+				}
+				configuration[k] = filters
+			}
+		case "triggers":
+			if values, ok := v.([]interface{}); ok {
+				configuration[k] = values
+			}
+		case "retry_count":
+			if n, ok := v.(float64); ok {
+				configuration[k] = int(n)
+			}
+		}
+	}
+
+	return []interface{}{configuration}
+}
@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).ChannelWebhooks(ctx)
+
+	params := &openapi.UpdateChannelWebhookParams{}
+
+	if d.HasChange("configuration.0.url") {
+		params.SetConfigurationUrl(d.Get("configuration.0.url").(string))
+	}
+	if d.HasChange("configuration.0.method") {
+		params.SetConfigurationMethod(d.Get("configuration.0.method").(string))
+	}
+	if d.HasChange("configuration.0.filters") {
+		params.SetConfigurationFilters(stringsFromSet(d.Get("configuration.0.filters").(*schema.Set)))
+	}
+	if d.HasChange("configuration.0.triggers") {
+		params.SetConfigurationTriggers(stringsFromSet(d.Get("configuration.0.triggers").(*schema.Set)))
+	}
+	if d.HasChange("configuration.0.flow_sid") {
+		params.SetConfigurationFlowSid(d.Get("configuration.0.flow_sid").(string))
+	}
+	if d.HasChange("configuration.0.retry_count") {
+		params.SetConfigurationRetryCount(d.Get("configuration.0.retry_count").(int))
+	}
+
+	_, err := client.UpdateChannelWebhook(d.Get("service_sid").(string), d.Get("channel_sid").(string), d.Id(), params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	return readContext(ctx, d, m)
+}
@@ -0,0 +1,180 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/events"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var configurationPath = cty.GetAttrPath("configuration").IndexInt(0)
+
+// parameterPaths maps the parameters of the channel webhook API to the attributes they are set from.
+var parameterPaths = api.ParameterPaths{
+	"Type":                     cty.GetAttrPath("type"),
+	"Configuration.Url":        configurationPath.GetAttr("url"),
+	"Configuration.Method":     configurationPath.GetAttr("method"),
+	"Configuration.Filters":    configurationPath.GetAttr("filters"),
+	"Configuration.Triggers":   configurationPath.GetAttr("triggers"),
+	"Configuration.FlowSid":    configurationPath.GetAttr("flow_sid"),
+	"Configuration.RetryCount": configurationPath.GetAttr("retry_count"),
+}
+
+// typeSettings lists the configuration settings each type of webhook requires.
+// Twilio ignores and does not report the settings of the other types, so
+// configuring them would show a diff on every plan.
+var typeSettings = map[string][]string{
+	"webhook": {"url", "filters"},
+	"trigger": {"url", "triggers"},
+	"studio":  {"flow_sid"},
+}
+
+var typedSettings = []string{"url", "filters", "triggers", "flow_sid"}
+
+func ResourceChannelWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createContext,
+		ReadContext:   readContext,
+		UpdateContext: updateContext,
+		DeleteContext: deleteContext,
+		CustomizeDiff: customdiff.All(
+			api.CustomizeDiffRegion,
+			customizeDiffConfiguration,
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: api.ImportStateWithParents("service_sid", "channel_sid"),
+		},
+		Schema: map[string]*schema.Schema{
+			"service_sid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"channel_sid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"webhook", "trigger", "studio"}, false),
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"GET", "POST"}, false),
+						},
+						"filters": {
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(events.Supported, false),
+							},
+							Optional: true,
+						},
+						"triggers": {
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Optional: true,
+						},
+						"flow_sid": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"retry_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 3),
+						},
+					},
+				},
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": api.RegionSchema(),
+		},
+	}
+}
+
+// customizeDiffConfiguration checks that the configuration holds the settings the type of the webhook requires, and none of the other types.
+func customizeDiffConfiguration(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	webhookType := d.Get("type").(string)
+
+	for _, name := range typedSettings {
+		key := "configuration.0." + name
+		if !d.NewValueKnown(key) {
+			continue
+		}
+
+		set := false
+		switch v := d.Get(key).(type) {
+		case string:
+			set = v != ""
+		case *schema.Set:
+			set = v.Len() > 0
+		}
+
+		required := containsString(typeSettings[webhookType], name)
+		if required && !set {
+			return fmt.Errorf("%s is required for %s webhooks", key, webhookType)
+		}
+		if !required && set {
+			return fmt.Errorf("%s cannot be set for %s webhooks", key, webhookType)
+		}
+	}
+
+	return nil
+}
+
+func containsString(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package webhook_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const resourceName = "twilio_chat_channel_webhook.test"
+
+func testAccChannelWebhookConfig(server *chattest.Server, webhookType string, configuration string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "webhooks"
+}
+
+resource "twilio_chat_channel" "test" {
+  service_sid = twilio_chat_service.test.id
+  unique_name = "support"
+}
+
+resource "twilio_chat_channel_webhook" "test" {
+  service_sid = twilio_chat_service.test.id
+  channel_sid = twilio_chat_channel.test.id
+  type        = %q
+
+  configuration {
+    %s
+  }
+}
+`, webhookType, configuration)
+}
+
+func TestAccChatChannelWebhook_webhook(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, channelSid, sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelWebhookConfig(server, "webhook", `
+    url     = "https://example.com/support"
+    filters = ["onMessageSent", "onMemberAdded"]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+					resource.TestCheckResourceAttr(resourceName, "type", "webhook"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.url", "https://example.com/support"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.filters.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.filters.*", "onMemberAdded"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retry_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "region", "us1"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccChannelWebhookConfig(server, "webhook", `
+    url         = "https://example.com/support/v2"
+    method      = "GET"
+    filters     = ["onMessageSent"]
+    retry_count = 3
+`),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.url", "https://example.com/support/v2"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.filters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retry_count", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return serviceSid + "/" + channelSid + "/" + sid, nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccChatChannelWebhook_triggerAndStudio(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, channelSid, sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelWebhookConfig(server, "trigger", `
+    url      = "https://example.com/keywords"
+    triggers = ["help", "agent"]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.triggers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.triggers.*", "agent"),
+				),
+			},
+			{
+				Config: testAccChannelWebhookConfig(server, "studio", `
+    flow_sid = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+					resource.TestCheckResourceAttr(resourceName, "type", "studio"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.flow_sid", "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.url", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatChannelWebhook_invalidConfiguration(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelWebhookConfig(server, "webhook", `
+    url     = "https://example.com/support"
+    filters = ["onMessageSentt"]
+`),
+				ExpectError: regexp.MustCompile(`expected configuration.0.filters.\d+ to be one of`),
+			},
+			{
+				Config: testAccChannelWebhookConfig(server, "webhook", `
+    url = "https://example.com/support"
+`),
+				ExpectError: regexp.MustCompile(`configuration.0.filters is required for webhook webhooks`),
+			},
+			{
+				Config: testAccChannelWebhookConfig(server, "studio", `
+    flow_sid = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+    url      = "https://example.com/support"
+`),
+				ExpectError: regexp.MustCompile(`configuration.0.url cannot be set for studio webhooks`),
+			},
+		},
+	})
+}
+
+func TestAccChatChannelWebhook_disappears(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, channelSid, sid string
+	config := testAccChannelWebhookConfig(server, "studio", `
+    flow_sid = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+`)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+					func(*terraform.State) error {
+						server.DeleteChannelWebhook(serviceSid, channelSid, sid)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+			},
+		},
+	})
+}
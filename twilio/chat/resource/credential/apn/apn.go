@@ -0,0 +1,99 @@
+package apn
+
+import (
+	"context"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// parameterPaths maps the parameters of the credential API to the attributes they are set from.
+var parameterPaths = api.ParameterPaths{
+	"FriendlyName": cty.GetAttrPath("friendly_name"),
+	"Certificate":  cty.GetAttrPath("certificate"),
+	"PrivateKey":   cty.GetAttrPath("private_key"),
+	"Sandbox":      cty.GetAttrPath("sandbox"),
+}
+
+func ResourceCredentialAPN() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createContext,
+		ReadContext:   readContext,
+		UpdateContext: updateContext,
+		DeleteContext: deleteContext,
+		CustomizeDiff: customdiff.All(
+			api.CustomizeDiffRegion,
+			customizeDiffKeyPair,
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext,
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceCredentialAPNV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeStateV0,
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"certificate": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: credential.ValidateCertificate,
+			},
+			"private_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: credential.ValidatePrivateKey,
+				StateFunc:    credential.HashSecret,
+			},
+			"sandbox": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": api.RegionSchema(),
+		},
+	}
+}
+
+// customizeDiffKeyPair checks that the private key belongs to the certificate,
+// so that a rotation that only replaces one of them fails at plan time.
+func customizeDiffKeyPair(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("certificate") || !d.NewValueKnown("private_key") {
+		return nil
+	}
+	if !d.HasChange("certificate") && !d.HasChange("private_key") {
+		return nil
+	}
+	return credential.CheckKeyPair(d.Get("certificate").(string), d.Get("private_key").(string))
+}
@@ -0,0 +1,153 @@
+package apn_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const resourceName = "twilio_chat_apn_credential.test"
+
+func testAccCheckCredentialCertificate(server *chattest.Server, sid *string, certificate string, privateKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		if *sid != "" && rs.Primary.ID != *sid {
+			return fmt.Errorf("APN credential was replaced, %s became %s", *sid, rs.Primary.ID)
+		}
+		*sid = rs.Primary.ID
+		if got, key := server.CredentialCertificate(*sid); got != certificate || key != privateKey {
+			return fmt.Errorf("certificate and private key of %s were not updated", *sid)
+		}
+		return nil
+	}
+}
+
+func testAccCredentialConfig(server *chattest.Server, friendlyName string, certificate string, privateKey string, sandbox bool) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_apn_credential" "test" {
+  friendly_name = %q
+  certificate   = %q
+  private_key   = %q
+  sandbox       = %t
+}
+`, friendlyName, certificate, privateKey, sandbox)
+}
+
+func TestAccChatAPNCredential_basic(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	certificate, privateKey := chattest.NewCertificate("com.example.app")
+	renewed, renewedKey := chattest.NewCertificate("com.example.app")
+	reissued := chattest.RenewCertificate("com.example.app", renewedKey)
+
+	var sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialConfig(server, "apn", certificate, privateKey, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredentialCertificate(server, &sid, certificate, privateKey),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "apn"),
+					resource.TestCheckResourceAttr(resourceName, "private_key", credential.HashSecret(privateKey)),
+					resource.TestCheckResourceAttr(resourceName, "sandbox", "true"),
+					resource.TestCheckResourceAttr(resourceName, "region", "us1"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccCredentialConfig(server, "apn", renewed, renewedKey, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredentialCertificate(server, &sid, renewed, renewedKey),
+					resource.TestCheckResourceAttr(resourceName, "private_key", credential.HashSecret(renewedKey)),
+					resource.TestCheckResourceAttr(resourceName, "sandbox", "false"),
+				),
+			},
+			{
+				// A renewed certificate can keep its key, which state only holds the hash of.
+				Config: testAccCredentialConfig(server, "apn", reissued, renewedKey, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredentialCertificate(server, &sid, reissued, renewedKey),
+					resource.TestCheckResourceAttr(resourceName, "private_key", credential.HashSecret(renewedKey)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "private_key"},
+			},
+		},
+	})
+}
+
+func TestAccChatAPNCredential_invalid(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	certificate, privateKey := chattest.NewCertificate("com.example.app")
+	_, otherKey := chattest.NewCertificate("com.example.other")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCredentialConfig(server, "apn", "not a certificate", privateKey, false),
+				ExpectError: regexp.MustCompile(`expected certificate to be a PEM encoded CERTIFICATE block`),
+			},
+			{
+				Config:      testAccCredentialConfig(server, "apn", certificate, certificate, false),
+				ExpectError: regexp.MustCompile(`expected private_key to be a PEM encoded private key, got a CERTIFICATE block`),
+			},
+			{
+				Config:      testAccCredentialConfig(server, "apn", certificate, otherKey, false),
+				ExpectError: regexp.MustCompile(`the private key does not match the certificate`),
+			},
+		},
+	})
+}
+
+func TestAccChatAPNCredential_disappears(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	certificate, privateKey := chattest.NewCertificate("com.example.app")
+
+	var sid string
+	config := testAccCredentialConfig(server, "apn", certificate, privateKey, false)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredentialCertificate(server, &sid, certificate, privateKey),
+					func(*terraform.State) error {
+						server.DeleteCredential(sid)
+						sid = ""
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  testAccCheckCredentialCertificate(server, &sid, certificate, privateKey),
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package apn
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var credentialType = "apn"
+
+func createContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Credentials(ctx)
+
+	params := &openapi.CreateCredentialParams{}
+	params.SetType(credentialType)
+	params.SetFriendlyName(d.Get("friendly_name").(string))
+	params.SetCertificate(d.Get("certificate").(string))
+	params.SetPrivateKey(d.Get("private_key").(string))
+	params.SetSandbox(d.Get("sandbox").(bool))
+
+	res, err := client.CreateCredential(params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	d.SetId(*res.Sid)
+
+	return readContext(ctx, d, m)
+}
@@ -0,0 +1,25 @@
+package apn
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func deleteContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Credentials(ctx)
+
+	var diags diag.Diagnostics
+
+	err := client.DeleteCredential(d.Id())
+	if err != nil && !api.IsNotFound(err) {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,51 @@
+package apn
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Credentials(ctx)
+
+	var diags diag.Diagnostics
+
+	res, err := client.FetchCredential(d.Id())
+	if err != nil {
+		if api.IsNotFound(err) {
+			log.Printf("[WARN] APN credential %s not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	if err := d.Set("friendly_name", res.FriendlyName); err != nil {
+		return diag.FromErr(err)
+	}
+	// Twilio reports the sandbox flag as the string "True" or "False".
+	if err := d.Set("sandbox", res.Sandbox != nil && strings.EqualFold(*res.Sandbox, "true")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := api.SetRegion(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", res.Url); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_created", res.DateCreated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
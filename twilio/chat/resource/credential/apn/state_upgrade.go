@@ -0,0 +1,55 @@
+package apn
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceCredentialAPNV0 is the schema of the states that held the private key in plain text.
+func resourceCredentialAPNV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"private_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sandbox": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": api.RegionSchema(),
+		},
+	}
+}
+
+// upgradeStateV0 replaces the private key with its hash, without a diff against the configured key.
+func upgradeStateV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	if privateKey, ok := rawState["private_key"].(string); ok {
+		rawState["private_key"] = credential.HashSecret(privateKey)
+	}
+	return rawState, nil
+}
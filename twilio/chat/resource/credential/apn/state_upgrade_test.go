@@ -0,0 +1,35 @@
+package apn
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+)
+
+func TestUpgradeStateV0(t *testing.T) {
+	cases := []struct {
+		name       string
+		privateKey interface{}
+		want       interface{}
+	}{
+		{"plain text", "private-key", credential.HashSecret("private-key")},
+		{"already hashed", credential.HashSecret("private-key"), credential.HashSecret("private-key")},
+		{"missing", nil, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state, err := upgradeStateV0(context.Background(), map[string]interface{}{"friendly_name": "apn", "private_key": c.privateKey}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if state["private_key"] != c.want {
+				t.Errorf("private_key = %v, want %v", state["private_key"], c.want)
+			}
+			if state["friendly_name"] != "apn" {
+				t.Errorf("friendly_name = %v, want apn", state["friendly_name"])
+			}
+		})
+	}
+}
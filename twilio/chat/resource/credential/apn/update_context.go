@@ -0,0 +1,39 @@
+package apn
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Credentials(ctx)
+
+	params := &openapi.UpdateCredentialParams{}
+
+	if d.HasChange("friendly_name") {
+		params.SetFriendlyName(d.Get("friendly_name").(string))
+	}
+	// The certificate and its key are rotated together, Twilio keeps the
+	// credential SID so the services using it keep working.
+	if d.HasChanges("certificate", "private_key") {
+		params.SetCertificate(d.Get("certificate").(string))
+		params.SetPrivateKey(credential.ConfiguredSecret(d, parameterPaths["PrivateKey"]))
+	}
+	if d.HasChange("sandbox") {
+		params.SetSandbox(d.Get("sandbox").(bool))
+	}
+
+	_, err := client.UpdateCredential(d.Id(), params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	return readContext(ctx, d, m)
+}
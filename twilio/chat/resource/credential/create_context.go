@@ -0,0 +1,45 @@
+package credential
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func createContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Credentials(ctx)
+
+	params := &openapi.CreateCredentialParams{}
+	params.SetFriendlyName(d.Get("friendly_name").(string))
+	params.SetType(d.Get("type").(string))
+
+	if _, ok := d.GetOk("fcm"); ok {
+		params.SetSecret(d.Get("fcm.0.secret").(string))
+	}
+	if _, ok := d.GetOk("gcm"); ok {
+		params.SetApiKey(d.Get("gcm.0.api_key").(string))
+	}
+	if _, ok := d.GetOk("apn"); ok {
+		params.SetCertificate(d.Get("apn.0.certificate").(string))
+		params.SetPrivateKey(d.Get("apn.0.private_key").(string))
+		params.SetSandbox(d.Get("apn.0.sandbox").(bool))
+	}
+
+	res, err := client.CreateCredential(params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	d.SetId(*res.Sid)
+
+	if err := setHashedSecrets(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readContext(ctx, d, m)
+}
@@ -0,0 +1,178 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// credentialTypes are the push notification services, each configured by the block of the same name.
+var credentialTypes = []string{"fcm", "gcm", "apn"}
+
+// parameterPaths maps the parameters of the credential API to the attributes they are set from.
+var parameterPaths = api.ParameterPaths{
+	"FriendlyName": cty.GetAttrPath("friendly_name"),
+	"Type":         cty.GetAttrPath("type"),
+	"Secret":       cty.GetAttrPath("fcm").IndexInt(0).GetAttr("secret"),
+	"ApiKey":       cty.GetAttrPath("gcm").IndexInt(0).GetAttr("api_key"),
+	"Certificate":  cty.GetAttrPath("apn").IndexInt(0).GetAttr("certificate"),
+	"PrivateKey":   cty.GetAttrPath("apn").IndexInt(0).GetAttr("private_key"),
+	"Sandbox":      cty.GetAttrPath("apn").IndexInt(0).GetAttr("sandbox"),
+}
+
+func ResourceCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createContext,
+		ReadContext:   readContext,
+		UpdateContext: updateContext,
+		DeleteContext: deleteContext,
+		CustomizeDiff: customdiff.All(
+			api.CustomizeDiffRegion,
+			customizeDiffType,
+			customizeDiffKeyPair,
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceCredentialV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeStateV0,
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(credentialTypes, false),
+			},
+			"fcm": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: credentialTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"secret": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: ValidateFCMSecret,
+							StateFunc:    HashSecret,
+						},
+						"secret_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"gcm": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: credentialTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+							StateFunc: HashSecret,
+						},
+					},
+				},
+			},
+			"apn": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: credentialTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateCertificate,
+						},
+						"private_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: ValidatePrivateKey,
+							StateFunc:    HashSecret,
+						},
+						"sandbox": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": api.RegionSchema(),
+		},
+	}
+}
+
+// customizeDiffType checks that the block configuring the credential is the one of its type.
+func customizeDiffType(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	credentialType := d.Get("type").(string)
+
+	for _, t := range credentialTypes {
+		if t != credentialType && len(d.Get(t).([]interface{})) > 0 {
+			return fmt.Errorf("a %s block cannot configure a credential of type %q, use a %s block", t, credentialType, credentialType)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffKeyPair checks that the private key of an APN credential belongs to its certificate.
+func customizeDiffKeyPair(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if len(d.Get("apn").([]interface{})) == 0 {
+		return nil
+	}
+	if !d.NewValueKnown("apn.0.certificate") || !d.NewValueKnown("apn.0.private_key") {
+		return nil
+	}
+	if !d.HasChange("apn.0.certificate") && !d.HasChange("apn.0.private_key") {
+		return nil
+	}
+	return CheckKeyPair(d.Get("apn.0.certificate").(string), d.Get("apn.0.private_key").(string))
+}
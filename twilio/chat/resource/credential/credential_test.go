@@ -0,0 +1,286 @@
+package credential_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const resourceName = "twilio_chat_credential.test"
+
+// testAccCheckCredential records the SID of the credential in sid, and checks
+// that the credential kept it or was replaced, as replaced tells.
+func testAccCheckCredential(server *chattest.Server, sid *string, replaced bool, check func(sid string) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		if *sid != "" && replaced != (rs.Primary.ID != *sid) {
+			return fmt.Errorf("chat credential %s became %s, want replaced %t", *sid, rs.Primary.ID, replaced)
+		}
+		*sid = rs.Primary.ID
+		if _, ok := server.Credential(*sid); !ok {
+			return fmt.Errorf("chat credential %s does not exist", *sid)
+		}
+		return check(*sid)
+	}
+}
+
+func testAccCredentialConfig(server *chattest.Server, credentialType string, block string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_credential" "test" {
+  friendly_name = "push"
+  type          = %q
+%s
+}
+`, credentialType, block)
+}
+
+func TestAccChatCredential_basic(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	first := chattest.NewFCMServerKey("first")
+	second := chattest.NewFCMServerKey("second")
+	certificate, privateKey := chattest.NewCertificate("com.example.app")
+	renewed, renewedKey := chattest.NewCertificate("com.example.app")
+
+	var sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialConfig(server, "fcm", fmt.Sprintf(`fcm { secret = %q }`, first)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredential(server, &sid, false, func(sid string) error {
+						if got := server.CredentialSecret(sid); got != first {
+							return fmt.Errorf("secret = %q, want %q", got, first)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "fcm.0.secret", credential.HashSecret(first)),
+					resource.TestCheckResourceAttr(resourceName, "type", "fcm"),
+					resource.TestCheckResourceAttr(resourceName, "region", "us1"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccCredentialConfig(server, "fcm", fmt.Sprintf(`fcm { secret = %q }`, second)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredential(server, &sid, false, func(sid string) error {
+						if got := server.CredentialSecret(sid); got != second {
+							return fmt.Errorf("secret = %q, want %q", got, second)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "fcm.0.secret", credential.HashSecret(second)),
+				),
+			},
+			{
+				Config: testAccCredentialConfig(server, "gcm", `gcm { api_key = "key" }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredential(server, &sid, true, func(sid string) error {
+						if got := server.CredentialAPIKey(sid); got != "key" {
+							return fmt.Errorf("api_key = %q, want key", got)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "type", "gcm"),
+					resource.TestCheckResourceAttr(resourceName, "gcm.0.api_key", credential.HashSecret("key")),
+				),
+			},
+			{
+				Config: testAccCredentialConfig(server, "apn", fmt.Sprintf(`apn {
+    certificate = %q
+    private_key = %q
+    sandbox     = true
+  }`, certificate, privateKey)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredential(server, &sid, true, func(sid string) error {
+						if got, _ := server.CredentialCertificate(sid); got != certificate {
+							return fmt.Errorf("certificate of %s was not set", sid)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "type", "apn"),
+					resource.TestCheckResourceAttr(resourceName, "apn.0.private_key", credential.HashSecret(privateKey)),
+					resource.TestCheckResourceAttr(resourceName, "apn.0.sandbox", "true"),
+				),
+			},
+			{
+				Config: testAccCredentialConfig(server, "apn", fmt.Sprintf(`apn {
+    certificate = %q
+    private_key = %q
+  }`, renewed, renewedKey)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredential(server, &sid, false, func(sid string) error {
+						if got, _ := server.CredentialCertificate(sid); got != renewed {
+							return fmt.Errorf("certificate of %s was not rotated", sid)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "apn.0.private_key", credential.HashSecret(renewedKey)),
+					resource.TestCheckResourceAttr(resourceName, "apn.0.sandbox", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apn"},
+			},
+		},
+	})
+}
+
+func TestAccChatCredential_secretRotation(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	secret := chattest.NewFCMServerKey("secret")
+	var sid string
+	block := func(version string) string {
+		return fmt.Sprintf(`
+  fcm {
+    secret         = %q
+    secret_version = %q
+  }
+`, secret, version)
+	}
+	checkWrites := func(want int) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if got := server.CredentialSecretWrites(sid); got != want {
+				return fmt.Errorf("secret written %d times, want %d", got, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialConfig(server, "fcm", block("1")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredential(server, &sid, false, func(sid string) error {
+						server.ReplaceCredentialSecret(sid, chattest.NewFCMServerKey("console"))
+						return nil
+					}),
+					checkWrites(1),
+				),
+			},
+			{
+				// Twilio never returns the secret, so the change in the console goes unnoticed.
+				Config:   testAccCredentialConfig(server, "fcm", block("1")),
+				PlanOnly: true,
+			},
+			{
+				// Bumping secret_version sends the secret again and keeps the SID.
+				Config: testAccCredentialConfig(server, "fcm", block("2")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredential(server, &sid, false, func(sid string) error {
+						if got := server.CredentialSecret(sid); got != secret {
+							return fmt.Errorf("secret = %q, want %q", got, secret)
+						}
+						return nil
+					}),
+					checkWrites(2),
+					resource.TestCheckResourceAttr(resourceName, "fcm.0.secret", credential.HashSecret(secret)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatCredential_invalid(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	secret := chattest.NewFCMServerKey("secret")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCredentialConfig(server, "fcm", ``),
+				ExpectError: regexp.MustCompile(`one of .apn,fcm,gcm. must be specified`),
+			},
+			{
+				Config:      testAccCredentialConfig(server, "fcm", `fcm { secret = "server-key" }`),
+				ExpectError: regexp.MustCompile(`expected \S*secret to be an FCM server key or a service account JSON key`),
+			},
+			{
+				Config:      testAccCredentialConfig(server, "fcm", `fcm { secret = jsonencode({ type = "authorized_user" }) }`),
+				ExpectError: regexp.MustCompile(`expected \S*secret to be a service account JSON key with type`),
+			},
+			{
+				Config: testAccCredentialConfig(server, "fcm", fmt.Sprintf(`
+  fcm { secret = %q }
+  gcm { api_key = "key" }
+`, secret)),
+				ExpectError: regexp.MustCompile(`only one of .apn,fcm,gcm. can be specified`),
+			},
+			{
+				Config:      testAccCredentialConfig(server, "apn", fmt.Sprintf(`fcm { secret = %q }`, secret)),
+				ExpectError: regexp.MustCompile(`a fcm block cannot configure a credential of type "apn"`),
+			},
+		},
+	})
+}
+
+// TestAccChatCredential_migrateFcmCredential imports a credential created by
+// twilio_chat_fcm_credential, as documented for the migration.
+func TestAccChatCredential_migrateFcmCredential(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	secret := chattest.NewFCMServerKey("secret")
+	var sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_fcm_credential" "test" {
+  friendly_name = "push"
+  secret        = %q
+}
+`, secret),
+				Check: func(s *terraform.State) error {
+					sid = s.RootModule().Resources["twilio_chat_fcm_credential.test"].Primary.ID
+					return nil
+				},
+			},
+			{
+				Config:       testAccCredentialConfig(server, "fcm", fmt.Sprintf(`fcm { secret = %q }`, secret)),
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return sid, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("imported %d credentials, want 1", len(states))
+					}
+					attributes := states[0].Attributes
+					if states[0].ID != sid || attributes["type"] != "fcm" || attributes["friendly_name"] != "push" {
+						return fmt.Errorf("imported credential = %s %v", states[0].ID, attributes)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
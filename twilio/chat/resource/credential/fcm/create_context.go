@@ -3,7 +3,8 @@ package fcm
 import (
 	"context"
 
-	tw "github.com/twilio/twilio-go"
+	"terraform-provider-twilio/twilio/api"
+
 	openapi "github.com/twilio/twilio-go/rest/chat/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,27 +14,22 @@ import (
 var credentialType = "fcm"
 
 func createContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*tw.RestClient)
-
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
+	client := m.(*api.Client).Credentials(ctx)
 
 	friendlyName := d.Get("friendly_name").(string)
 	secret := d.Get("secret").(string)
 
-	res, err := client.ChatV2.CreateCredential(&openapi.CreateCredentialParams{
+	res, err := client.CreateCredential(&openapi.CreateCredentialParams{
 		FriendlyName: &friendlyName,
 		Type:         &credentialType,
 		Secret:       &secret,
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return api.DiagFromErr(err, parameterPaths)
 	}
 
 	d.SetId(*res.Sid)
 
-	readContext(ctx, d, m)
-
-	return diags
+	return readContext(ctx, d, m)
 }
@@ -3,21 +3,21 @@ package fcm
 import (
 	"context"
 
-	tw "github.com/twilio/twilio-go"
+	"terraform-provider-twilio/twilio/api"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func deleteContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*tw.RestClient)
+	client := m.(*api.Client).Credentials(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	err := client.ChatV2.DeleteCredential(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	err := client.DeleteCredential(d.Id())
+	if err != nil && !api.IsNotFound(err) {
+		return api.DiagFromErr(err, parameterPaths)
 	}
 
 	d.SetId("")
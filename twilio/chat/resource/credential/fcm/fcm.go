@@ -1,15 +1,46 @@
 package fcm
 
 import (
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// parameterPaths maps the parameters of the credential API to the attributes they are set from.
+var parameterPaths = api.ParameterPaths{
+	"FriendlyName": cty.GetAttrPath("friendly_name"),
+	"Secret":       cty.GetAttrPath("secret"),
+}
+
 func ResourceCredentialService() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: createContext,
 		ReadContext:   readContext,
 		UpdateContext: updateContext,
 		DeleteContext: deleteContext,
+		CustomizeDiff: api.CustomizeDiffRegion,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importContext,
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceCredentialServiceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeStateV0,
+			},
+		},
+		DeprecationMessage: "twilio_chat_fcm_credential is superseded by twilio_chat_credential, see its documentation to migrate",
 		Schema: map[string]*schema.Schema{
 			"friendly_name": {
 				Type:     schema.TypeString,
@@ -21,9 +52,15 @@ func ResourceCredentialService() *schema.Resource {
 				Computed: true,
 			},
 			"secret": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: credential.ValidateFCMSecret,
+				StateFunc:    credential.HashSecret,
+			},
+			"secret_version": {
 				Type:     schema.TypeString,
-				Computed: false,
-				Required: true,
+				Optional: true,
 			},
 			"date_created": {
 				Type:     schema.TypeString,
@@ -33,6 +70,7 @@ func ResourceCredentialService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"region": api.RegionSchema(),
 		},
 	}
 }
@@ -0,0 +1,185 @@
+package fcm_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const resourceName = "twilio_chat_fcm_credential.test"
+
+func testAccCheckCredentialSecret(server *chattest.Server, sid *string, secret string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		*sid = rs.Primary.ID
+		if got := server.CredentialSecret(*sid); got != secret {
+			return fmt.Errorf("secret = %q, want %q", got, secret)
+		}
+		return nil
+	}
+}
+
+func testAccCredentialConfig(server *chattest.Server, friendlyName string, secret string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_fcm_credential" "test" {
+  friendly_name = %q
+  secret        = %q
+}
+`, friendlyName, secret)
+}
+
+func TestAccChatFcmCredential_basic(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	first := chattest.NewFCMServerKey("first")
+	second := chattest.NewFCMServerKey("second")
+
+	var sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialConfig(server, "fcm", first),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredentialSecret(server, &sid, first),
+					resource.TestCheckResourceAttr(resourceName, "secret", credential.HashSecret(first)),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "fcm"),
+					resource.TestCheckResourceAttr(resourceName, "region", "us1"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccCredentialConfig(server, "renamed", second),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredentialSecret(server, &sid, second),
+					resource.TestCheckResourceAttr(resourceName, "secret", credential.HashSecret(second)),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "renamed"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func TestAccChatFcmCredential_disappears(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var sid string
+	secret := chattest.NewFCMServerKey("secret")
+	config := testAccCredentialConfig(server, "fcm", secret)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredentialSecret(server, &sid, secret),
+					func(*terraform.State) error {
+						server.DeleteCredential(sid)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  testAccCheckCredentialSecret(server, &sid, secret),
+			},
+		},
+	})
+}
+
+func testAccCredentialConfigVersion(server *chattest.Server, secret string, version string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_fcm_credential" "test" {
+  friendly_name  = "fcm"
+  secret         = %q
+  secret_version = %q
+}
+`, secret, version)
+}
+
+func testAccCheckCredentialSecretWrites(server *chattest.Server, sid *string, writes int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := server.CredentialSecretWrites(*sid); got != writes {
+			return fmt.Errorf("secret of %s was written %d times, want %d", *sid, got, writes)
+		}
+		return nil
+	}
+}
+
+func TestAccChatFcmCredential_secretRotation(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	secret := chattest.NewFCMServerKey("secret")
+
+	var sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialConfigVersion(server, secret, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredentialSecret(server, &sid, secret),
+					testAccCheckCredentialSecretWrites(server, &sid, 1),
+					func(*terraform.State) error {
+						server.ReplaceCredentialSecret(sid, chattest.NewFCMServerKey("console"))
+						return nil
+					},
+				),
+			},
+			{
+				// Twilio never returns the secret, so the change in the console goes unnoticed.
+				Config:   testAccCredentialConfigVersion(server, secret, "1"),
+				PlanOnly: true,
+			},
+			{
+				// Bumping secret_version sends the secret again and keeps the SID.
+				Config: testAccCredentialConfigVersion(server, secret, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCredentialSecret(server, &sid, secret),
+					testAccCheckCredentialSecretWrites(server, &sid, 2),
+					resource.TestCheckResourceAttr(resourceName, "secret", credential.HashSecret(secret)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatFcmCredential_invalidSecret(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCredentialConfig(server, "fcm", "not-a-server-key"),
+				ExpectError: regexp.MustCompile(`expected secret to be an FCM server key or a service account JSON key`),
+			},
+		},
+	})
+}
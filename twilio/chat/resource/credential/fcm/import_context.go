@@ -0,0 +1,26 @@
+package fcm
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func importContext(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*api.Client).Credentials(ctx)
+
+	res, err := client.FetchCredential(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Type == nil || *res.Type != credentialType {
+		return nil, fmt.Errorf("credential %s is not a %s credential", d.Id(), credentialType)
+	}
+
+	// Twilio never returns the secret, it is written to state by the next apply.
+	return []*schema.ResourceData{d}, nil
+}
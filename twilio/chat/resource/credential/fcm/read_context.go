@@ -2,30 +2,39 @@ package fcm
 
 import (
 	"context"
+	"log"
 	"time"
 
-	tw "github.com/twilio/twilio-go"
+	"terraform-provider-twilio/twilio/api"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*tw.RestClient)
+	client := m.(*api.Client).Credentials(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	credentialSid := d.Id()
 
-	res, err := client.ChatV2.FetchCredential(credentialSid)
+	res, err := client.FetchCredential(credentialSid)
 	if err != nil {
-		return diag.FromErr(err)
+		if api.IsNotFound(err) {
+			log.Printf("[WARN] FCM credential %s not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+		return api.DiagFromErr(err, parameterPaths)
 	}
 
 	if err := d.Set("friendly_name", res.FriendlyName); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := api.SetRegion(d, m); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("url", res.Url); err != nil {
 		return diag.FromErr(err)
 	}
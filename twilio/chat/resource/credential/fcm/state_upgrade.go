@@ -0,0 +1,47 @@
+package fcm
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceCredentialServiceV0 is the schema of the states that held the secret in plain text.
+func resourceCredentialServiceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": api.RegionSchema(),
+		},
+	}
+}
+
+// upgradeStateV0 replaces the secret with its hash, without a diff against the configured secret.
+func upgradeStateV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	if secret, ok := rawState["secret"].(string); ok {
+		rawState["secret"] = credential.HashSecret(secret)
+	}
+	return rawState, nil
+}
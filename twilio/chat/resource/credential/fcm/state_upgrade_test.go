@@ -0,0 +1,35 @@
+package fcm
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+)
+
+func TestUpgradeStateV0(t *testing.T) {
+	cases := []struct {
+		name   string
+		secret interface{}
+		want   interface{}
+	}{
+		{"plain text", "server-key", credential.HashSecret("server-key")},
+		{"already hashed", credential.HashSecret("server-key"), credential.HashSecret("server-key")},
+		{"missing", nil, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state, err := upgradeStateV0(context.Background(), map[string]interface{}{"friendly_name": "fcm", "secret": c.secret}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if state["secret"] != c.want {
+				t.Errorf("secret = %v, want %v", state["secret"], c.want)
+			}
+			if state["friendly_name"] != "fcm" {
+				t.Errorf("friendly_name = %v, want fcm", state["friendly_name"])
+			}
+		})
+	}
+}
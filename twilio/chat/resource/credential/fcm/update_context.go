@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
-	tw "github.com/twilio/twilio-go"
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+
 	openapi "github.com/twilio/twilio-go/rest/chat/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,7 +14,7 @@ import (
 )
 
 func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*tw.RestClient)
+	client := m.(*api.Client).Credentials(ctx)
 
 	params := &openapi.UpdateCredentialParams{}
 
@@ -22,14 +24,15 @@ func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) d
 		}
 	}
 
-	if d.HasChange("secret") {
-		secret := d.Get("secret").(string)
-		params.SetSecret(secret)
+	// Bumping secret_version sends the secret again, Twilio keeps the
+	// credential SID so the services using it keep working.
+	if d.HasChanges("secret", "secret_version") {
+		params.SetSecret(credential.ConfiguredSecret(d, parameterPaths["Secret"]))
 	}
 
-	res, err := client.ChatV2.UpdateCredential(d.Id(), params)
+	res, err := client.UpdateCredential(d.Id(), params)
 	if err != nil {
-		return diag.FromErr(err)
+		return api.DiagFromErr(err, parameterPaths)
 	}
 
 	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
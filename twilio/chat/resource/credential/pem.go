@@ -0,0 +1,55 @@
+// Package credential implements the twilio_chat_credential resource, and the
+// certificate checks it shares with twilio_chat_apn_credential.
+package credential
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// ValidateCertificate is a ValidateFunc for PEM encoded X.509 certificates.
+func ValidateCertificate(v interface{}, k string) (warnings []string, errs []error) {
+	block, _ := pem.Decode([]byte(v.(string)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, []error{fmt.Errorf("expected %s to be a PEM encoded CERTIFICATE block", k)}
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a valid X.509 certificate: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// ValidatePrivateKey is a ValidateFunc for PEM encoded private keys. The
+// errors never include the key.
+func ValidatePrivateKey(v interface{}, k string) (warnings []string, errs []error) {
+	block, _ := pem.Decode([]byte(v.(string)))
+	if block == nil {
+		return nil, []error{fmt.Errorf("expected %s to be a PEM encoded private key", k)}
+	}
+
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		_, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		_, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		_, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, []error{fmt.Errorf("expected %s to be a PEM encoded private key, got a %s block", k, block.Type)}
+	}
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a valid private key: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// CheckKeyPair returns an error when privateKey is not the key of certificate.
+func CheckKeyPair(certificate string, privateKey string) error {
+	if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+		return fmt.Errorf("the private key does not match the certificate: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package credential_test
+
+import (
+	"strings"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+	"terraform-provider-twilio/twilio/chat/resource/credential"
+)
+
+func TestValidateCertificate(t *testing.T) {
+	certificate, privateKey := chattest.NewCertificate("com.example.app")
+
+	cases := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"certificate", certificate, true},
+		{"exported with bag attributes", "Bag Attributes\n    friendlyName: Apple Push Services\n" + certificate, true},
+		{"private key", privateKey, false},
+		{"not PEM", "certificate", false},
+		{"corrupt", strings.Replace(certificate, "MII", "MIX", 1), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, errs := credential.ValidateCertificate(c.value, "certificate")
+			if valid := len(errs) == 0; valid != c.valid {
+				t.Errorf("ValidateCertificate() errors = %v, want valid %t", errs, c.valid)
+			}
+		})
+	}
+}
+
+func TestValidatePrivateKey(t *testing.T) {
+	certificate, privateKey := chattest.NewCertificate("com.example.app")
+
+	cases := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"private key", privateKey, true},
+		{"certificate", certificate, false},
+		{"not PEM", "key", false},
+		{"corrupt", strings.Replace(privateKey, "EC PRIVATE KEY", "RSA PRIVATE KEY", 2), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, errs := credential.ValidatePrivateKey(c.value, "private_key")
+			if valid := len(errs) == 0; valid != c.valid {
+				t.Errorf("ValidatePrivateKey() errors = %v, want valid %t", errs, c.valid)
+			}
+			for _, err := range errs {
+				if strings.Contains(err.Error(), strings.Split(privateKey, "\n")[1]) {
+					t.Errorf("ValidatePrivateKey() error %q includes the key", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckKeyPair(t *testing.T) {
+	certificate, privateKey := chattest.NewCertificate("com.example.app")
+	_, otherKey := chattest.NewCertificate("com.example.other")
+
+	if err := credential.CheckKeyPair(certificate, privateKey); err != nil {
+		t.Errorf("CheckKeyPair() of a matching pair = %v", err)
+	}
+	if err := credential.CheckKeyPair(certificate, otherKey); err == nil {
+		t.Errorf("CheckKeyPair() of another key succeeded")
+	}
+}
@@ -0,0 +1,59 @@
+package credential
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Credentials(ctx)
+
+	var diags diag.Diagnostics
+
+	res, err := client.FetchCredential(d.Id())
+	if err != nil {
+		if api.IsNotFound(err) {
+			log.Printf("[WARN] Chat credential %s not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	if err := d.Set("friendly_name", res.FriendlyName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", res.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	// Twilio never returns the secrets, only the sandbox flag of APN
+	// credentials is refreshed. It is reported as "True" or "False".
+	if apn := d.Get("apn").([]interface{}); len(apn) > 0 && apn[0] != nil && res.Sandbox != nil {
+		settings := apn[0].(map[string]interface{})
+		settings["sandbox"] = strings.EqualFold(*res.Sandbox, "true")
+		if err := d.Set("apn", []interface{}{settings}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := api.SetRegion(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", res.Url); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_created", res.DateCreated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
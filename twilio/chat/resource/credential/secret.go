@@ -0,0 +1,97 @@
+package credential
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretHashPrefix = "sha256:"
+
+// HashSecret is a StateFunc that keeps the fingerprint of a secret in state
+// instead of the secret. Hashes are returned unchanged, so that it can also
+// upgrade states that already hold one.
+func HashSecret(v interface{}) string {
+	secret, _ := v.(string)
+	if secret == "" || strings.HasPrefix(secret, secretHashPrefix) {
+		return secret
+	}
+	sum := sha256.Sum256([]byte(secret))
+	return secretHashPrefix + hex.EncodeToString(sum[:])
+}
+
+// ConfiguredSecret returns the secret configured at path. State and plan only
+// hold its HashSecret, so the secrets sent to Twilio are read from the
+// configuration.
+func ConfiguredSecret(d interface{ GetRawConfig() cty.Value }, path cty.Path) string {
+	v, err := path.Apply(d.GetRawConfig())
+	if err != nil || !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+	return v.AsString()
+}
+
+// hashedSecrets are the secrets of the credential blocks, kept in state as their HashSecret.
+var hashedSecrets = map[string]string{
+	"fcm": "secret",
+	"gcm": "api_key",
+	"apn": "private_key",
+}
+
+// setHashedSecrets replaces the secrets of the configured block with their
+// HashSecret. The SDK only applies the StateFunc of attributes in nested
+// blocks to plans, so the state written by an apply would hold the secret.
+func setHashedSecrets(d *schema.ResourceData) error {
+	for block, key := range hashedSecrets {
+		settings, ok := d.Get(block).([]interface{})
+		if !ok || len(settings) == 0 || settings[0] == nil {
+			continue
+		}
+		setting := settings[0].(map[string]interface{})
+		setting[key] = HashSecret(setting[key])
+		if err := d.Set(block, []interface{}{setting}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	// fcmServerKey matches the legacy server keys of the Firebase console, such as AAAA...:APA91b...
+	fcmServerKey = regexp.MustCompile(`^[A-Za-z0-9_-]{7,}:APA91b[A-Za-z0-9_-]{64,}$`)
+	// googleAPIKey matches the API keys that older Firebase and GCM projects use as server keys.
+	googleAPIKey = regexp.MustCompile(`^AIza[A-Za-z0-9_-]{35}$`)
+)
+
+// ValidateFCMSecret is a ValidateFunc for FCM secrets, either a server key or
+// the JSON key of a service account. The errors never include the secret.
+func ValidateFCMSecret(v interface{}, k string) (warnings []string, errs []error) {
+	secret := strings.TrimSpace(v.(string))
+
+	if strings.HasPrefix(secret, "{") {
+		var account struct {
+			Type        string `json:"type"`
+			ProjectID   string `json:"project_id"`
+			ClientEmail string `json:"client_email"`
+			PrivateKey  string `json:"private_key"`
+		}
+		if err := json.Unmarshal([]byte(secret), &account); err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be a service account JSON key, but it is not valid JSON", k)}
+		}
+		if account.Type != "service_account" || account.ProjectID == "" || account.ClientEmail == "" || account.PrivateKey == "" {
+			return nil, []error{fmt.Errorf("expected %s to be a service account JSON key with type, project_id, client_email and private_key", k)}
+		}
+		return nil, nil
+	}
+
+	if !fcmServerKey.MatchString(secret) && !googleAPIKey.MatchString(secret) {
+		return nil, []error{fmt.Errorf("expected %s to be an FCM server key or a service account JSON key", k)}
+	}
+	return nil, nil
+}
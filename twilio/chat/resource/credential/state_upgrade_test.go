@@ -0,0 +1,40 @@
+package credential
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUpgradeStateV0(t *testing.T) {
+	cases := []struct {
+		name  string
+		block string
+		key   string
+	}{
+		{"fcm secret", "fcm", "secret"},
+		{"gcm api key", "gcm", "api_key"},
+		{"apn private key", "apn", "private_key"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for _, secret := range []string{"secret", HashSecret("secret")} {
+				state, err := upgradeStateV0(context.Background(), map[string]interface{}{
+					"friendly_name": "push",
+					"type":          c.block,
+					c.block:         []interface{}{map[string]interface{}{c.key: secret}},
+				}, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := state[c.block].([]interface{})[0].(map[string]interface{})[c.key]
+				if got != HashSecret("secret") {
+					t.Errorf("%s = %v, want %v", c.key, got, HashSecret("secret"))
+				}
+				if state["friendly_name"] != "push" {
+					t.Errorf("friendly_name = %v, want push", state["friendly_name"])
+				}
+			}
+		})
+	}
+}
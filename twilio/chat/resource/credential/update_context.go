@@ -0,0 +1,49 @@
+package credential
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Credentials(ctx)
+
+	params := &openapi.UpdateCredentialParams{}
+
+	if d.HasChange("friendly_name") {
+		params.SetFriendlyName(d.Get("friendly_name").(string))
+	}
+	// Bumping secret_version sends the secret again, Twilio keeps the
+	// credential SID so the services using it keep working.
+	if d.HasChanges("fcm.0.secret", "fcm.0.secret_version") {
+		params.SetSecret(ConfiguredSecret(d, parameterPaths["Secret"]))
+	}
+	if d.HasChange("gcm.0.api_key") {
+		params.SetApiKey(ConfiguredSecret(d, parameterPaths["ApiKey"]))
+	}
+	// The certificate and its key are rotated together.
+	if d.HasChanges("apn.0.certificate", "apn.0.private_key") {
+		params.SetCertificate(d.Get("apn.0.certificate").(string))
+		params.SetPrivateKey(ConfiguredSecret(d, parameterPaths["PrivateKey"]))
+	}
+	if d.HasChange("apn.0.sandbox") {
+		params.SetSandbox(d.Get("apn.0.sandbox").(bool))
+	}
+
+	_, err := client.UpdateCredential(d.Id(), params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	if err := setHashedSecrets(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readContext(ctx, d, m)
+}
@@ -0,0 +1,33 @@
+package member
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func createContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Members(ctx)
+
+	params := &openapi.CreateMemberParams{}
+	params.SetIdentity(d.Get("identity").(string))
+	params.SetAttributes(d.Get("attributes").(string))
+
+	if v, ok := d.GetOk("role_sid"); ok {
+		params.SetRoleSid(v.(string))
+	}
+
+	res, err := client.CreateMember(d.Get("service_sid").(string), d.Get("channel_sid").(string), params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	d.SetId(*res.Sid)
+
+	return readContext(ctx, d, m)
+}
@@ -0,0 +1,156 @@
+package member_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const resourceName = "twilio_chat_channel_member.test"
+
+func testAccCheckMemberDefaultRole(server *chattest.Server, serviceSid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		svc, ok := server.Service(*serviceSid)
+		if !ok {
+			return fmt.Errorf("chat service %s does not exist", *serviceSid)
+		}
+		return resource.TestCheckResourceAttr(resourceName, "role_sid", *svc.DefaultChannelRoleSid)(s)
+	}
+}
+
+func testAccMemberConfigBasic(server *chattest.Server) string {
+	return server.ProviderConfig() + `
+resource "twilio_chat_service" "test" {
+  friendly_name = "members"
+}
+
+resource "twilio_chat_channel" "test" {
+  service_sid = twilio_chat_service.test.id
+  unique_name = "support"
+}
+
+resource "twilio_chat_channel_member" "test" {
+  service_sid = twilio_chat_service.test.id
+  channel_sid = twilio_chat_channel.test.id
+  identity    = "support-bot"
+}
+`
+}
+
+func testAccMemberConfigModerator(server *chattest.Server, attributes string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "members"
+}
+
+resource "twilio_chat_channel" "test" {
+  service_sid = twilio_chat_service.test.id
+  unique_name = "support"
+}
+
+resource "twilio_chat_role" "moderator" {
+  service_sid   = twilio_chat_service.test.id
+  friendly_name = "moderator"
+  type          = "channel"
+  permissions   = ["sendMessage", "removeMember"]
+}
+
+resource "twilio_chat_channel_member" "test" {
+  service_sid = twilio_chat_service.test.id
+  channel_sid = twilio_chat_channel.test.id
+  identity    = "support-bot"
+  role_sid    = twilio_chat_role.moderator.id
+  attributes  = %s
+}
+`, attributes)
+}
+
+func TestAccChatChannelMember_basic(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, channelSid, sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberConfigBasic(server),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+					testAccCheckMemberDefaultRole(server, &serviceSid),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_sid", "twilio_chat_channel.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "identity", "support-bot"),
+					resource.TestCheckResourceAttr(resourceName, "attributes", "{}"),
+					resource.TestCheckResourceAttr(resourceName, "region", "us1"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccMemberConfigModerator(server, `jsonencode({ bot = true })`),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+					resource.TestCheckResourceAttrPair(resourceName, "role_sid", "twilio_chat_role.moderator", "id"),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"bot":true}`),
+					resource.TestCheckResourceAttr("twilio_chat_channel.test", "members_count", "1"),
+				),
+			},
+			{
+				Config:   testAccMemberConfigModerator(server, `"{ \"bot\": true }"`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return serviceSid + "/" + channelSid + "/" + sid, nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccChatChannelMember_leaves(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, channelSid, sid, leftSid string
+	config := testAccMemberConfigBasic(server)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+					func(*terraform.State) error {
+						leftSid = sid
+						server.DeleteMember(serviceSid, channelSid, sid)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &channelSid, &sid),
+					func(*terraform.State) error {
+						if sid == leftSid {
+							return fmt.Errorf("chat channel member %s was not added back", sid)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
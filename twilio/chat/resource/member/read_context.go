@@ -0,0 +1,64 @@
+package member
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Members(ctx)
+
+	var diags diag.Diagnostics
+
+	res, err := client.FetchMember(d.Get("service_sid").(string), d.Get("channel_sid").(string), d.Id())
+	if err != nil {
+		// Members can leave a channel or be removed by a moderator at any
+		// time; forgetting them makes the next apply add them back.
+		if api.IsNotFound(err) {
+			log.Printf("[WARN] Chat channel member %s not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	if err := d.Set("service_sid", res.ServiceSid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("channel_sid", res.ChannelSid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("identity", res.Identity); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("role_sid", res.RoleSid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("attributes", res.Attributes); err != nil {
+		return diag.FromErr(err)
+	}
+	// Twilio reports no index until the member has consumed a message.
+	if err := d.Set("last_consumed_message_index", res.LastConsumedMessageIndex); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := api.SetRegion(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", res.Url); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_created", res.DateCreated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
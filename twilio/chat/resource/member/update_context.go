@@ -0,0 +1,32 @@
+package member
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Members(ctx)
+
+	params := &openapi.UpdateMemberParams{}
+
+	if d.HasChange("role_sid") {
+		params.SetRoleSid(d.Get("role_sid").(string))
+	}
+	if d.HasChange("attributes") {
+		params.SetAttributes(d.Get("attributes").(string))
+	}
+
+	_, err := client.UpdateMember(d.Get("service_sid").(string), d.Get("channel_sid").(string), d.Id(), params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	return readContext(ctx, d, m)
+}
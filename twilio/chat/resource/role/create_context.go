@@ -0,0 +1,43 @@
+package role
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func permissionsFromSet(d *schema.ResourceData) []string {
+	permissions := []string{}
+	for _, p := range d.Get("permissions").(*schema.Set).List() {
+		permissions = append(permissions, p.(string))
+	}
+	return permissions
+}
+
+func createContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Roles(ctx)
+
+	serviceSid := d.Get("service_sid").(string)
+	friendlyName := d.Get("friendly_name").(string)
+	roleType := d.Get("type").(string)
+	permissions := permissionsFromSet(d)
+
+	res, err := client.CreateRole(serviceSid, &openapi.CreateRoleParams{
+		FriendlyName: &friendlyName,
+		Type:         &roleType,
+		Permission:   &permissions,
+	})
+
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	d.SetId(*res.Sid)
+
+	return readContext(ctx, d, m)
+}
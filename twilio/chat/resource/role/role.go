@@ -0,0 +1,100 @@
+package role
+
+import (
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var supportPermissions = []string{
+	"addMember",
+	"createChannel",
+	"deleteAnyMessage",
+	"deleteOwnMessage",
+	"destroyChannel",
+	"editAnyMessage",
+	"editAnyMessageAttributes",
+	"editAnyUserInfo",
+	"editChannelAttributes",
+	"editChannelName",
+	"editNotificationLevel",
+	"editOwnMessage",
+	"editOwnMessageAttributes",
+	"editOwnUserInfo",
+	"inviteMember",
+	"joinChannel",
+	"leaveChannel",
+	"removeMember",
+	"sendMediaMessage",
+	"sendMessage",
+}
+
+// parameterPaths maps the parameters of the role API to the attributes they are set from.
+var parameterPaths = api.ParameterPaths{
+	"FriendlyName": cty.GetAttrPath("friendly_name"),
+	"Type":         cty.GetAttrPath("type"),
+	"Permission":   cty.GetAttrPath("permissions"),
+}
+
+func ResourceRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createContext,
+		ReadContext:   readContext,
+		UpdateContext: updateContext,
+		DeleteContext: deleteContext,
+		CustomizeDiff: api.CustomizeDiffRegion,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: api.ImportStateWithParents("service_sid"),
+		},
+		Schema: map[string]*schema.Schema{
+			"service_sid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"channel", "deployment"}, false),
+			},
+			"permissions": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(supportPermissions, false),
+				},
+				Required: true,
+				MinItems: 1,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": api.RegionSchema(),
+		},
+	}
+}
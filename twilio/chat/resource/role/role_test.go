@@ -0,0 +1,154 @@
+package role_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const resourceName = "twilio_chat_role.test"
+
+func testAccCheckRoleExists(server *chattest.Server, serviceSid *string, sid *string, permissions int) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		server.CheckExists(resourceName, serviceSid, sid),
+		func(s *terraform.State) error {
+			role, _ := server.Role(*serviceSid, *sid)
+			if len(*role.Permissions) != permissions {
+				return fmt.Errorf("chat role %s has permissions %v, want %d", *sid, *role.Permissions, permissions)
+			}
+			return nil
+		},
+	)
+}
+
+func testAccRoleConfig(server *chattest.Server, roleType string, permissions ...string) string {
+	quoted := make([]string, len(permissions))
+	for i, p := range permissions {
+		quoted[i] = fmt.Sprintf("%q", p)
+	}
+
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "roles"
+}
+
+resource "twilio_chat_role" "test" {
+  service_sid   = twilio_chat_service.test.id
+  friendly_name = "moderator"
+  type          = %q
+  permissions   = [%s]
+}
+`, roleType, strings.Join(quoted, ", "))
+}
+
+func TestAccChatRole_basic(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, sid, replacedSid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig(server, "channel", "sendMessage", "removeMember"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(server, &serviceSid, &sid, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "service_sid", "twilio_chat_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "moderator"),
+					resource.TestCheckResourceAttr(resourceName, "type", "channel"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "removeMember"),
+					resource.TestCheckResourceAttr(resourceName, "region", "us1"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccRoleConfig(server, "channel", "removeMember", "sendMessage", "sendMediaMessage"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(server, &serviceSid, &replacedSid, 3),
+					func(*terraform.State) error {
+						if replacedSid != sid {
+							return fmt.Errorf("changing permissions replaced role %s with %s", sid, replacedSid)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccRoleConfig(server, "deployment", "createChannel"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(server, &serviceSid, &replacedSid, 1),
+					resource.TestCheckResourceAttr(resourceName, "type", "deployment"),
+					func(*terraform.State) error {
+						if replacedSid == sid {
+							return fmt.Errorf("changing the type did not replace role %s", sid)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return serviceSid + "/" + replacedSid, nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccChatRole_invalidPermission(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleConfig(server, "channel", "sendMessage", "fly"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected permissions\.\d+ to be one of`),
+			},
+		},
+	})
+}
+
+func TestAccChatRole_disappears(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, sid string
+	config := testAccRoleConfig(server, "channel", "sendMessage")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(server, &serviceSid, &sid, 1),
+					func(*terraform.State) error {
+						server.DeleteRole(serviceSid, sid)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  testAccCheckRoleExists(server, &serviceSid, &sid, 1),
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package role
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Roles(ctx)
+
+	// Twilio replaces the permissions of a role, the other attributes force a new role.
+	permissions := permissionsFromSet(d)
+
+	_, err := client.UpdateRole(d.Get("service_sid").(string), d.Id(), &openapi.UpdateRoleParams{
+		Permission: &permissions,
+	})
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	return readContext(ctx, d, m)
+}
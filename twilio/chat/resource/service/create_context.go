@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"fmt"
+
+	"terraform-provider-twilio/twilio/api"
 
-	tw "github.com/twilio/twilio-go"
 	openapi "github.com/twilio/twilio-go/rest/chat/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,24 +13,36 @@ import (
 )
 
 func createContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*tw.RestClient)
-
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
+	client := m.(*api.Client).Services(ctx)
 
 	friendlyName := d.Get("friendly_name").(string)
 
-	res, err := client.ChatV2.CreateService(&openapi.CreateServiceParams{
+	res, err := client.CreateService(&openapi.CreateServiceParams{
 		FriendlyName: &friendlyName,
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return api.DiagFromErr(err, parameterPaths)
 	}
 
-	updateContext(ctx, d, m)
-
 	d.SetId(*res.Sid)
 
-	return diags
+	// Twilio only accepts the friendly name on create, the remaining settings
+	// are applied by an update. Roll the service back if they are rejected.
+	if _, err := client.UpdateService(d.Id(), updateServiceParams(d)); err != nil {
+		if rollbackErr := client.DeleteService(d.Id()); rollbackErr != nil && !api.IsNotFound(rollbackErr) {
+			// The service still exists, keep its ID so that Terraform tracks it as tainted.
+			d.Partial(true)
+			return append(api.DiagFromErr(err, parameterPaths), diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("failed to roll back chat service %s", d.Id()),
+				Detail:   rollbackErr.Error(),
+			})
+		}
+
+		d.SetId("")
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	return readContext(ctx, d, m)
 }
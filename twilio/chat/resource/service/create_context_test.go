@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	twclient "github.com/twilio/twilio-go/client"
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fakeChat serves services from memory. Calls to the credential API panic.
+type fakeChat struct {
+	api.ChatAPI
+
+	services  map[string]*openapi.ChatV2Service
+	updateErr error
+	deleteErr error
+	deleted   []string
+}
+
+func newFakeChat() *fakeChat {
+	return &fakeChat{services: map[string]*openapi.ChatV2Service{}}
+}
+
+func (f *fakeChat) CreateService(params *openapi.CreateServiceParams) (*openapi.ChatV2Service, error) {
+	now := time.Now()
+	sid := "IS00000000000000000000000000000001"
+	f.services[sid] = &openapi.ChatV2Service{
+		Sid:          &sid,
+		FriendlyName: params.FriendlyName,
+		DateCreated:  &now,
+		DateUpdated:  &now,
+	}
+	return f.services[sid], nil
+}
+
+func (f *fakeChat) FetchService(sid string) (*openapi.ChatV2Service, error) {
+	if cs, ok := f.services[sid]; ok {
+		return cs, nil
+	}
+	return nil, &twclient.TwilioRestError{Status: 404, Code: 20404}
+}
+
+func (f *fakeChat) UpdateService(sid string, params *openapi.UpdateServiceParams) (*openapi.ChatV2Service, error) {
+	if f.updateErr != nil {
+		return nil, f.updateErr
+	}
+	cs := f.services[sid]
+	if params.PreWebhookUrl != nil {
+		cs.PreWebhookUrl = params.PreWebhookUrl
+	}
+	return cs, nil
+}
+
+func (f *fakeChat) DeleteService(sid string) error {
+	f.deleted = append(f.deleted, sid)
+	if f.deleteErr != nil {
+		return f.deleteErr
+	}
+	delete(f.services, sid)
+	return nil
+}
+
+func TestCreateContext(t *testing.T) {
+	rejected := &twclient.TwilioRestError{Status: 400, Code: 20001, Message: "Parameter 'PreWebhookUrl' is not valid"}
+
+	tests := map[string]struct {
+		updateErr   error
+		deleteErr   error
+		wantID      bool
+		wantDeleted bool
+		wantErrors  int
+		wantPath    cty.Path
+	}{
+		"settings applied": {
+			wantID: true,
+		},
+		"rolled back": {
+			updateErr:   rejected,
+			wantDeleted: true,
+			wantErrors:  1,
+			wantPath:    cty.GetAttrPath("webhooks").IndexInt(0).GetAttr("pre_hook_url"),
+		},
+		"rollback failed": {
+			updateErr:   rejected,
+			deleteErr:   errors.New("connection reset"),
+			wantID:      true,
+			wantDeleted: true,
+			wantErrors:  2,
+			wantPath:    cty.GetAttrPath("webhooks").IndexInt(0).GetAttr("pre_hook_url"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			chat := newFakeChat()
+			chat.updateErr = tt.updateErr
+			chat.deleteErr = tt.deleteErr
+
+			d := schema.TestResourceDataRaw(t, ResourceCredentialService().Schema, map[string]interface{}{
+				"friendly_name": "test",
+				"webhooks": []interface{}{
+					map[string]interface{}{"pre_hook_url": "https://example.com"},
+				},
+			})
+
+			diags := createContext(context.Background(), d, api.NewClientWithChat(chat))
+
+			if len(diags) != tt.wantErrors {
+				t.Errorf("createContext() returned %d diagnostics, want %d: %v", len(diags), tt.wantErrors, diags)
+			}
+			if len(diags) > 0 && !diags[0].AttributePath.Equals(tt.wantPath) {
+				t.Errorf("createContext() pointed at %#v, want %#v", diags[0].AttributePath, tt.wantPath)
+			}
+			if (d.Id() != "") != tt.wantID {
+				t.Errorf("createContext() recorded ID %q, want an ID: %v", d.Id(), tt.wantID)
+			}
+			if (len(chat.deleted) > 0) != tt.wantDeleted {
+				t.Errorf("createContext() deleted %v, want a rollback: %v", chat.deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
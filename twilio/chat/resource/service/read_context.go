@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"log"
 	"time"
 
-	tw "github.com/twilio/twilio-go"
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/events"
+
 	openapi "github.com/twilio/twilio-go/rest/chat/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -79,7 +82,11 @@ func webhookFromResponse(cs *openapi.ChatV2Service) *map[string]interface{} {
 	webhook := map[string]interface{}{}
 
 	if cs.WebhookFilters != nil {
-		webhook["events"] = *cs.WebhookFilters
+		filters := []interface{}{}
+		for _, e := range *cs.WebhookFilters {
+			filters = append(filters, events.Canonical(e))
+		}
+		webhook["events"] = filters
 	}
 	if cs.WebhookMethod != nil {
 		webhook["method"] = *cs.WebhookMethod
@@ -132,88 +139,102 @@ func notificationsFromResponse(noti map[string]interface{}) map[string]interface
 	return setting
 }
 
-func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*tw.RestClient)
-
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
-
-	serviceSid := d.Id()
-
-	res, err := client.ChatV2.FetchService(serviceSid)
-	if err != nil {
-		return diag.FromErr(err)
+func flattenRoles(cs *openapi.ChatV2Service) []map[string]interface{} {
+	roles := []map[string]interface{}{}
+	if ss := rolesFromResponse(cs); ss != nil {
+		roles = append(roles, *ss)
 	}
+	return roles
+}
 
-	if err := d.Set("friendly_name", res.FriendlyName); err != nil {
-		return diag.FromErr(err)
+func flattenLimits(cs *openapi.ChatV2Service) []map[string]interface{} {
+	limits := []map[string]interface{}{}
+	if cs.Limits != nil {
+		limits = append(limits, limitsFromResponse(*cs.Limits))
 	}
-	if err := d.Set("date_created", res.DateCreated.Format(time.RFC3339)); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
-		return diag.FromErr(err)
+	return limits
+}
+
+func flattenAdditionalSettings(cs *openapi.ChatV2Service) []map[string]interface{} {
+	additionalSettings := []map[string]interface{}{}
+	if ss := additionalSettingsFromResponse(cs); ss != nil {
+		additionalSettings = append(additionalSettings, *ss)
 	}
+	return additionalSettings
+}
 
-	if changed, ok := d.Get("roles").([]interface{}); ok {
-		if len(changed) > 0 {
-			roles := []map[string]interface{}{}
-			if ss := rolesFromResponse(res); ss != nil {
-				roles = append(roles, *ss)
-			}
-			if err := d.Set("roles", roles); err != nil {
-				return diag.FromErr(err)
-			}
-		}
+func flattenWebhooks(cs *openapi.ChatV2Service) []map[string]interface{} {
+	webhooks := []map[string]interface{}{}
+	if ss := webhookFromResponse(cs); ss != nil {
+		webhooks = append(webhooks, *ss)
 	}
+	return webhooks
+}
 
-	if changed, ok := d.Get("limits").([]interface{}); ok {
-		if len(changed) > 0 {
-			limits := []map[string]interface{}{}
-			if res.Limits != nil {
-				limits = append(limits, limitsFromResponse(*res.Limits))
-			}
-			if err := d.Set("limits", limits); err != nil {
-				return diag.FromErr(err)
-			}
-		}
+func flattenNotifications(cs *openapi.ChatV2Service) []map[string]interface{} {
+	notifications := []map[string]interface{}{}
+	if cs.Notifications != nil {
+		notifications = append(notifications, notificationsFromResponse(*cs.Notifications))
 	}
+	return notifications
+}
 
-	if changed, ok := d.Get("additional_settings").([]interface{}); ok {
-		if len(changed) > 0 {
-			additionalSettings := []map[string]interface{}{}
-			if ss := additionalSettingsFromResponse(res); ss != nil {
-				additionalSettings = append(additionalSettings, *ss)
-			}
-			if err := d.Set("additional_settings", additionalSettings); err != nil {
-				return diag.FromErr(err)
-			}
+var nestedBlocks = map[string]func(cs *openapi.ChatV2Service) []map[string]interface{}{
+	"roles":               flattenRoles,
+	"limits":              flattenLimits,
+	"additional_settings": flattenAdditionalSettings,
+	"webhooks":            flattenWebhooks,
+	"notifications":       flattenNotifications,
+}
+
+func setNestedBlocks(d *schema.ResourceData, cs *openapi.ChatV2Service) error {
+	for name, flatten := range nestedBlocks {
+		if err := d.Set(name, flatten(cs)); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	if changed, ok := d.Get("webhooks").([]interface{}); ok {
-		if len(changed) > 0 {
-			webhooks := []map[string]interface{}{}
-			if ss := webhookFromResponse(res); ss != nil {
-				webhooks = append(webhooks, *ss)
-			}
-			if err := d.Set("webhooks", webhooks); err != nil {
-				return diag.FromErr(err)
-			}
+func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Services(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	serviceSid := d.Id()
+
+	res, err := client.FetchService(serviceSid)
+	if err != nil {
+		if api.IsNotFound(err) {
+			log.Printf("[WARN] Chat service %s not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
 		}
+		return api.DiagFromErr(err, parameterPaths)
 	}
 
-	if changed, ok := d.Get("notifications").([]interface{}); ok {
-		if len(changed) > 0 {
-			notifications := []map[string]interface{}{}
-			if res.Notifications != nil {
-				notifications = append(notifications, notificationsFromResponse(*res.Notifications))
-			}
-			if err := d.Set("notifications", notifications); err != nil {
-				return diag.FromErr(err)
-			}
-		}
+	if err := SetAttributes(d, m, res); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
 }
+
+// SetAttributes sets the attributes of the service res in d, which is also
+// used by the twilio_chat_service data source.
+func SetAttributes(d *schema.ResourceData, m interface{}, res *openapi.ChatV2Service) error {
+	if err := d.Set("friendly_name", res.FriendlyName); err != nil {
+		return err
+	}
+	if err := api.SetRegion(d, m); err != nil {
+		return err
+	}
+	if err := d.Set("date_created", res.DateCreated.Format(time.RFC3339)); err != nil {
+		return err
+	}
+	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
+		return err
+	}
+	return setNestedBlocks(d, res)
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+func stringPtr(v string) *string { return &v }
+func intPtr(v int) *int          { return &v }
+func boolPtr(v bool) *bool       { return &v }
+
+func TestRolesFromResponse(t *testing.T) {
+	tests := map[string]struct {
+		service openapi.ChatV2Service
+		want    *map[string]interface{}
+	}{
+		"no roles": {
+			service: openapi.ChatV2Service{},
+			want:    nil,
+		},
+		"every role": {
+			service: openapi.ChatV2Service{
+				DefaultServiceRoleSid:        stringPtr("RL1"),
+				DefaultChannelRoleSid:        stringPtr("RL2"),
+				DefaultChannelCreatorRoleSid: stringPtr("RL3"),
+			},
+			want: &map[string]interface{}{
+				"default_service_role":         "RL1",
+				"default_channel_role":         "RL2",
+				"default_channel_creator_role": "RL3",
+			},
+		},
+		"service role only": {
+			service: openapi.ChatV2Service{
+				DefaultServiceRoleSid: stringPtr("RL1"),
+			},
+			want: &map[string]interface{}{
+				"default_service_role": "RL1",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := rolesFromResponse(&tt.service); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rolesFromResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimitsFromResponse(t *testing.T) {
+	tests := map[string]struct {
+		limits map[string]interface{}
+		want   map[string]interface{}
+	}{
+		"empty": {
+			limits: map[string]interface{}{},
+			want:   map[string]interface{}{},
+		},
+		"both limits": {
+			limits: map[string]interface{}{"channel_members": float64(100), "user_channels": float64(250)},
+			want:   map[string]interface{}{"channel_members": float64(100), "user_channels": float64(250)},
+		},
+		"unknown limits are dropped": {
+			limits: map[string]interface{}{"channel_members": float64(100), "user_invites": float64(5)},
+			want:   map[string]interface{}{"channel_members": float64(100)},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := limitsFromResponse(tt.limits); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("limitsFromResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdditionalSettingsFromResponse(t *testing.T) {
+	tests := map[string]struct {
+		service openapi.ChatV2Service
+		want    *map[string]interface{}
+	}{
+		"no settings": {
+			service: openapi.ChatV2Service{},
+			want:    nil,
+		},
+		"every setting": {
+			service: openapi.ChatV2Service{
+				ReachabilityEnabled:       boolPtr(true),
+				ReadStatusEnabled:         boolPtr(false),
+				ConsumptionReportInterval: intPtr(10),
+				TypingIndicatorTimeout:    intPtr(5),
+				PreWebhookRetryCount:      intPtr(1),
+				PostWebhookRetryCount:     intPtr(2),
+			},
+			want: &map[string]interface{}{
+				"reachability_enabled":        true,
+				"read_status_enabled":         false,
+				"consumption_report_interval": 10,
+				"typing_indicator_timeout":    5,
+				"pre_webhook_retry_count":     1,
+				"post_webhook_retry_count":    2,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := additionalSettingsFromResponse(&tt.service); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("additionalSettingsFromResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationsFromResponse(t *testing.T) {
+	tests := map[string]struct {
+		notifications map[string]interface{}
+		want          map[string]interface{}
+	}{
+		"disabled": {
+			notifications: map[string]interface{}{
+				"log_enabled": false,
+				"new_message": map[string]interface{}{"enabled": false},
+			},
+			want: map[string]interface{}{
+				"log_enabled": false,
+				"new_message": []map[string]interface{}{
+					{"enabled": false},
+				},
+			},
+		},
+		"templates": {
+			notifications: map[string]interface{}{
+				"log_enabled": true,
+				"new_message": map[string]interface{}{
+					"enabled":             true,
+					"template":            "${USER}: ${MESSAGE}",
+					"sound":               "default",
+					"badge_count_enabled": true,
+				},
+				"added_to_channel": map[string]interface{}{
+					"enabled":  true,
+					"template": "${CHANNEL}",
+				},
+			},
+			want: map[string]interface{}{
+				"log_enabled": true,
+				"new_message": []map[string]interface{}{
+					{"enabled": true, "template": "${USER}: ${MESSAGE}", "sound": "default", "badge_count_enabled": true},
+				},
+				"added_to_channel": []map[string]interface{}{
+					{"enabled": true, "template": "${CHANNEL}"},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := notificationsFromResponse(tt.notifications); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("notificationsFromResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookFromResponse(t *testing.T) {
+	tests := map[string]struct {
+		service openapi.ChatV2Service
+		want    *map[string]interface{}
+	}{
+		"no webhooks": {
+			service: openapi.ChatV2Service{},
+			want:    nil,
+		},
+		"events are spelled as configured": {
+			service: openapi.ChatV2Service{
+				WebhookFilters: &[]string{"onmessagesent", "onMessageSend", "onUnknownEvent"},
+				WebhookMethod:  stringPtr("POST"),
+				PreWebhookUrl:  stringPtr("https://example.com/pre"),
+			},
+			want: &map[string]interface{}{
+				"events":       []interface{}{"onMessageSent", "onMessageSend", "onUnknownEvent"},
+				"method":       "POST",
+				"pre_hook_url": "https://example.com/pre",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := webhookFromResponse(&tt.service); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("webhookFromResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookFromResponse_legacyEventsWithoutDiff(t *testing.T) {
+	r := &schema.Resource{Schema: ResourceCredentialService().Schema}
+	raw := map[string]interface{}{
+		"friendly_name": "test",
+		"webhooks": []interface{}{
+			map[string]interface{}{
+				"events":        []interface{}{"onMessageSend", "onMessageSent", "onChannelAdd", "onChannelAdded"},
+				"method":        "POST",
+				"post_hook_url": "https://example.com/post",
+			},
+		},
+	}
+
+	// Twilio returns the pairs in another order and spelling than configured.
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("IS00000000000000000000000000000000")
+	webhook := webhookFromResponse(&openapi.ChatV2Service{
+		WebhookFilters: &[]string{"onchanneladded", "ONMESSAGESEND", "onChannelAdd", "onmessagesent"},
+		WebhookMethod:  stringPtr("POST"),
+		PostWebhookUrl: stringPtr("https://example.com/post"),
+	})
+	if err := d.Set("webhooks", []interface{}{*webhook}); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil {
+		return
+	}
+	for k, attr := range diff.Attributes {
+		if strings.HasPrefix(k, "webhooks.") {
+			t.Errorf("reading the events back planned a change of %s: %q => %q", k, attr.Old, attr.New)
+		}
+	}
+}
@@ -1,36 +1,16 @@
 package service
 
 import (
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+	"terraform-provider-twilio/twilio/chat/events"
+
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var supportWebHookEvents = []string{
-	"onMessageSend",
-	"onMessageUpdate",
-	"onMessageRemove",
-	"onMediaMessageSend",
-	"onChannelAdd",
-	"onChannelUpdate",
-	"onChannelDestroy",
-	"onMemberAdd",
-	"onMemberUpdate",
-	"onMemberRemove",
-	"onUserUpdate",
-	"onMessageSent",
-	"onMessageUpdated",
-	"onMessageRemoved",
-	"onMediaMessageSent",
-	"onChannelAdded",
-	"onChannelUpdated",
-	"onChannelDestroyed",
-	"onMemberAdded",
-	"onMemberUpdated",
-	"onMemberRemoved",
-	"onUserAdded",
-	"onUserUpdated",
-}
-
 var roles = schema.Schema{
 	Type: schema.TypeList,
 	Elem: &schema.Resource{
@@ -38,22 +18,22 @@ var roles = schema.Schema{
 			"default_channel_creator_role": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: false,
+				Computed: true,
 			},
 			"default_channel_role": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: false,
+				Computed: true,
 			},
 			"default_service_role": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: false,
+				Computed: true,
 			},
 		},
 	},
 	Optional: true,
-	Computed: false,
+	Computed: true,
 	MaxItems: 1,
 }
 
@@ -64,19 +44,19 @@ var limits = schema.Schema{
 			"channel_members": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				Computed:     false,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(1, 1000),
 			},
 			"user_channels": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				Computed:     false,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(1, 1000),
 			},
 		},
 	},
 	Optional: true,
-	Computed: false,
+	Computed: true,
 	MaxItems: 1,
 }
 
@@ -87,39 +67,39 @@ var additionalSettings = schema.Schema{
 			"reachability_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				Computed: false,
+				Computed: true,
 			},
 			"read_status_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				Computed: false,
+				Computed: true,
 			},
 			"consumption_report_interval": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				Computed: false,
+				Computed: true,
 			},
 			"typing_indicator_timeout": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				Computed: false,
+				Computed: true,
 			},
 			"pre_webhook_retry_count": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				Computed:     false,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(0, 3),
 			},
 			"post_webhook_retry_count": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				Computed:     false,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(0, 3),
 			},
 		},
 	},
 	Optional: true,
-	Computed: false,
+	Computed: true,
 	MaxItems: 1,
 }
 
@@ -128,36 +108,35 @@ var webhooks = schema.Schema{
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"events": {
-				Type: schema.TypeList,
+				Type: schema.TypeSet,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(supportWebHookEvents, false),
+					ValidateFunc: validation.StringInSlice(events.Supported, false),
 				},
 				Optional: true,
-				Computed: false,
 			},
 			"method": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Computed:     false,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice([]string{"GET", "POST"}, false),
 			},
 			"pre_hook_url": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Computed:     false,
+				Computed:     true,
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 			"post_hook_url": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Computed:     false,
+				Computed:     true,
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 		},
 	},
 	Optional: true,
-	Computed: false,
+	Computed: true,
 	MaxItems: 1,
 }
 
@@ -165,17 +144,17 @@ var notificationTemplate = schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"enabled": {
 			Type:     schema.TypeBool,
-			Computed: false,
+			Computed: true,
 			Optional: true,
 		},
 		"template": {
 			Type:     schema.TypeString,
-			Computed: false,
+			Computed: true,
 			Optional: true,
 		},
 		"sound": {
 			Type:     schema.TypeString,
-			Computed: false,
+			Computed: true,
 			Optional: true,
 		},
 	},
@@ -185,22 +164,22 @@ var notificationTemplateWithBadgeCount = schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"enabled": {
 			Type:     schema.TypeBool,
-			Computed: false,
+			Computed: true,
 			Optional: true,
 		},
 		"template": {
 			Type:     schema.TypeString,
-			Computed: false,
+			Computed: true,
 			Optional: true,
 		},
 		"sound": {
 			Type:     schema.TypeString,
-			Computed: false,
+			Computed: true,
 			Optional: true,
 		},
 		"badge_count_enabled": {
 			Type:     schema.TypeBool,
-			Computed: false,
+			Computed: true,
 			Optional: true,
 		},
 	},
@@ -212,50 +191,97 @@ var notifications = schema.Schema{
 		Schema: map[string]*schema.Schema{
 			"log_enabled": {
 				Type:     schema.TypeBool,
-				Computed: false,
+				Computed: true,
 				Optional: true,
 			},
 			"new_message": {
 				Type:     schema.TypeList,
 				Elem:     &notificationTemplateWithBadgeCount,
-				Computed: false,
+				Computed: true,
 				Optional: true,
 				MaxItems: 1,
 			},
 			"invited_to_channel": {
 				Type:     schema.TypeList,
 				Elem:     &notificationTemplate,
-				Computed: false,
+				Computed: true,
 				Optional: true,
 				MaxItems: 1,
 			},
 			"added_to_channel": {
 				Type:     schema.TypeList,
 				Elem:     &notificationTemplate,
-				Computed: false,
+				Computed: true,
 				Optional: true,
 				MaxItems: 1,
 			},
 			"removed_from_channel": {
 				Type:     schema.TypeList,
 				Elem:     &notificationTemplate,
-				Computed: false,
+				Computed: true,
 				Optional: true,
 				MaxItems: 1,
 			},
 		},
 	},
 	Optional: true,
-	Computed: false,
+	Computed: true,
 	MaxItems: 1,
 }
 
+var webhooksPath = cty.GetAttrPath("webhooks").IndexInt(0)
+var notificationsPath = cty.GetAttrPath("notifications").IndexInt(0)
+
+// parameterPaths maps the parameters of the service API to the attributes they are set from.
+var parameterPaths = api.ParameterPaths{
+	"FriendlyName":                               cty.GetAttrPath("friendly_name"),
+	"DefaultServiceRoleSid":                      cty.GetAttrPath("roles").IndexInt(0).GetAttr("default_service_role"),
+	"DefaultChannelRoleSid":                      cty.GetAttrPath("roles").IndexInt(0).GetAttr("default_channel_role"),
+	"DefaultChannelCreatorRoleSid":               cty.GetAttrPath("roles").IndexInt(0).GetAttr("default_channel_creator_role"),
+	"Limits.ChannelMembers":                      cty.GetAttrPath("limits").IndexInt(0).GetAttr("channel_members"),
+	"Limits.UserChannels":                        cty.GetAttrPath("limits").IndexInt(0).GetAttr("user_channels"),
+	"ReachabilityEnabled":                        cty.GetAttrPath("additional_settings").IndexInt(0).GetAttr("reachability_enabled"),
+	"ReadStatusEnabled":                          cty.GetAttrPath("additional_settings").IndexInt(0).GetAttr("read_status_enabled"),
+	"ConsumptionReportInterval":                  cty.GetAttrPath("additional_settings").IndexInt(0).GetAttr("consumption_report_interval"),
+	"TypingIndicatorTimeout":                     cty.GetAttrPath("additional_settings").IndexInt(0).GetAttr("typing_indicator_timeout"),
+	"PreWebhookRetryCount":                       cty.GetAttrPath("additional_settings").IndexInt(0).GetAttr("pre_webhook_retry_count"),
+	"PostWebhookRetryCount":                      cty.GetAttrPath("additional_settings").IndexInt(0).GetAttr("post_webhook_retry_count"),
+	"PreWebhookUrl":                              webhooksPath.GetAttr("pre_hook_url"),
+	"PostWebhookUrl":                             webhooksPath.GetAttr("post_hook_url"),
+	"WebhookMethod":                              webhooksPath.GetAttr("method"),
+	"WebhookFilters":                             webhooksPath.GetAttr("events"),
+	"Notifications.LogEnabled":                   notificationsPath.GetAttr("log_enabled"),
+	"Notifications.NewMessage.Enabled":           notificationsPath.GetAttr("new_message").IndexInt(0).GetAttr("enabled"),
+	"Notifications.NewMessage.Template":          notificationsPath.GetAttr("new_message").IndexInt(0).GetAttr("template"),
+	"Notifications.NewMessage.Sound":             notificationsPath.GetAttr("new_message").IndexInt(0).GetAttr("sound"),
+	"Notifications.NewMessage.BadgeCountEnabled": notificationsPath.GetAttr("new_message").IndexInt(0).GetAttr("badge_count_enabled"),
+	"Notifications.AddedToChannel.Enabled":       notificationsPath.GetAttr("added_to_channel").IndexInt(0).GetAttr("enabled"),
+	"Notifications.AddedToChannel.Template":      notificationsPath.GetAttr("added_to_channel").IndexInt(0).GetAttr("template"),
+	"Notifications.AddedToChannel.Sound":         notificationsPath.GetAttr("added_to_channel").IndexInt(0).GetAttr("sound"),
+	"Notifications.RemovedFromChannel.Enabled":   notificationsPath.GetAttr("removed_from_channel").IndexInt(0).GetAttr("enabled"),
+	"Notifications.RemovedFromChannel.Template":  notificationsPath.GetAttr("removed_from_channel").IndexInt(0).GetAttr("template"),
+	"Notifications.RemovedFromChannel.Sound":     notificationsPath.GetAttr("removed_from_channel").IndexInt(0).GetAttr("sound"),
+	"Notifications.InvitedToChannel.Enabled":     notificationsPath.GetAttr("invited_to_channel").IndexInt(0).GetAttr("enabled"),
+	"Notifications.InvitedToChannel.Template":    notificationsPath.GetAttr("invited_to_channel").IndexInt(0).GetAttr("template"),
+	"Notifications.InvitedToChannel.Sound":       notificationsPath.GetAttr("invited_to_channel").IndexInt(0).GetAttr("sound"),
+}
+
 func ResourceCredentialService() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: createContext,
 		ReadContext:   readContext,
 		UpdateContext: updateContext,
 		DeleteContext: deleteContext,
+		CustomizeDiff: api.CustomizeDiffRegion,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"friendly_name": {
 				Type:     schema.TypeString,
@@ -270,6 +296,7 @@ func ResourceCredentialService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"region":              api.RegionSchema(),
 			"roles":               &roles,
 			"limits":              &limits,
 			"additional_settings": &additionalSettings,
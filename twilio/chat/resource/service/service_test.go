@@ -0,0 +1,509 @@
+package service_test
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const resourceName = "twilio_chat_service.test"
+
+func testAccCheckServiceDestroy(server *chattest.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if sids := server.ServiceSids(); len(sids) > 0 {
+			return fmt.Errorf("chat services still exist: %v", sids)
+		}
+		return nil
+	}
+}
+
+func testAccServiceConfigBasic(server *chattest.Server, friendlyName string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = %q
+}
+`, friendlyName)
+}
+
+func testAccServiceConfigWebhooks(server *chattest.Server, url string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "webhooks"
+  webhooks {
+    method       = "POST"
+    pre_hook_url = %q
+  }
+}
+`, url)
+}
+
+func testAccServiceConfigEvents(server *chattest.Server, events ...string) string {
+	quoted := make([]string, len(events))
+	for i, e := range events {
+		quoted[i] = fmt.Sprintf("%q", e)
+	}
+
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "events"
+  webhooks {
+    events        = [%s]
+    pre_hook_url  = "https://example.com/pre"
+    post_hook_url = "https://example.com/post"
+  }
+}
+`, strings.Join(quoted, ", "))
+}
+
+func testAccCheckServiceWebhookFilters(server *chattest.Server, sid *string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cs, ok := server.Service(*sid)
+		if !ok {
+			return fmt.Errorf("chat service %s does not exist", *sid)
+		}
+		got := append([]string{}, *cs.WebhookFilters...)
+		want := append([]string{}, want...)
+		sort.Strings(got)
+		sort.Strings(want)
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("chat service %s has webhook filters %v, want %v", *sid, got, want)
+		}
+		return nil
+	}
+}
+
+func testAccServiceConfigRoles(server *chattest.Server, serviceRole string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "roles"
+  roles {
+    default_service_role = %q
+  }
+}
+`, serviceRole)
+}
+
+// testAccServiceConfigFull sets every nested block of the first service
+// created by server, whose default roles have predictable SIDs.
+func testAccServiceConfigFull(server *chattest.Server) string {
+	serviceAdmin := chattest.Sid("RL", 2)
+	channelAdmin := chattest.Sid("RL", 4)
+
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "full"
+  roles {
+    default_service_role         = %q
+    default_channel_role         = %q
+    default_channel_creator_role = %q
+  }
+  limits {
+    user_channels   = 500
+    channel_members = 50
+  }
+  additional_settings {
+    reachability_enabled        = true
+    read_status_enabled         = false
+    consumption_report_interval = 20
+    typing_indicator_timeout    = 10
+    pre_webhook_retry_count     = 2
+    post_webhook_retry_count    = 3
+  }
+  webhooks {
+    method        = "GET"
+    pre_hook_url  = "https://example.com/pre"
+    post_hook_url = "https://example.com/post"
+  }
+  notifications {
+    log_enabled = true
+    new_message {
+      enabled             = true
+      template            = "$${USER}: $${MESSAGE}"
+      sound               = "default"
+      badge_count_enabled = true
+    }
+    invited_to_channel {
+      enabled  = true
+      template = "$${USER} invited you to $${CHANNEL}"
+      sound    = "default"
+    }
+    added_to_channel {
+      enabled  = true
+      template = "You were added to $${CHANNEL}"
+      sound    = "default"
+    }
+    removed_from_channel {
+      enabled  = true
+      template = "You were removed from $${CHANNEL}"
+      sound    = "default"
+    }
+  }
+}
+`, serviceAdmin, channelAdmin, channelAdmin)
+}
+
+// testAccServiceConfigPartial sets a single setting of each nested block, the
+// others keep the defaults of Twilio.
+func testAccServiceConfigPartial(server *chattest.Server, channelMembers int) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "partial"
+  roles {
+    default_service_role = %q
+  }
+  limits {
+    channel_members = %d
+  }
+  additional_settings {
+    reachability_enabled = true
+  }
+  webhooks {
+    pre_hook_url = "https://example.com/pre"
+  }
+  notifications {
+    new_message {
+      enabled = true
+    }
+  }
+}
+`, chattest.Sid("RL", 2), channelMembers)
+}
+
+func TestAccChatService_basic(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      testAccCheckServiceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfigBasic(server, "basic"),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &sid),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "basic"),
+					resource.TestCheckResourceAttr(resourceName, "region", "us1"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.default_service_role", chattest.Sid("RL", 3)),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.channel_members", "100"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.user_channels", "250"),
+					resource.TestCheckResourceAttr(resourceName, "additional_settings.0.read_status_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.0.method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.0.new_message.0.enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "date_created"),
+				),
+			},
+			{
+				Config: testAccServiceConfigBasic(server, "renamed"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "friendly_name", "renamed"),
+			},
+		},
+	})
+}
+
+func TestAccChatService_update(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      testAccCheckServiceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfigBasic(server, "basic"),
+				Check:  server.CheckExists(resourceName, &sid),
+			},
+			{
+				Config: testAccServiceConfigFull(server),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "full"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.default_service_role", chattest.Sid("RL", 2)),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.default_channel_role", chattest.Sid("RL", 4)),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.default_channel_creator_role", chattest.Sid("RL", 4)),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.user_channels", "500"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.channel_members", "50"),
+					resource.TestCheckResourceAttr(resourceName, "additional_settings.0.reachability_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "additional_settings.0.read_status_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "additional_settings.0.consumption_report_interval", "20"),
+					resource.TestCheckResourceAttr(resourceName, "additional_settings.0.typing_indicator_timeout", "10"),
+					resource.TestCheckResourceAttr(resourceName, "additional_settings.0.pre_webhook_retry_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "additional_settings.0.post_webhook_retry_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.0.method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.0.pre_hook_url", "https://example.com/pre"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.0.post_hook_url", "https://example.com/post"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.0.log_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.0.new_message.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.0.new_message.0.badge_count_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.0.new_message.0.template", "${USER}: ${MESSAGE}"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.0.invited_to_channel.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.0.added_to_channel.0.sound", "default"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.0.removed_from_channel.0.template", "You were removed from ${CHANNEL}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChatService_import(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      testAccCheckServiceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfigWebhooks(server, "https://example.com/pre"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChatService_drift(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var sid string
+	config := testAccServiceConfigWebhooks(server, "https://example.com/pre")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      testAccCheckServiceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  server.CheckExists(resourceName, &sid),
+			},
+			{
+				PreConfig: func() {
+					server.UpdateService(sid, func(cs *openapi.ChatV2Service) {
+						url := "https://example.com/changed"
+						cs.PreWebhookUrl = &url
+					})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(resourceName, "webhooks.0.pre_hook_url", "https://example.com/pre"),
+			},
+		},
+	})
+}
+
+func TestAccChatService_webhookEvents(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var sid string
+	config := testAccServiceConfigEvents(server, "onMessageSent", "onChannelAdded", "onMessageSend")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      testAccCheckServiceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfigEvents(server, "onMessageSent", "onMessageSend"),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &sid),
+					testAccCheckServiceWebhookFilters(server, &sid, "onMessageSend", "onMessageSent"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.0.events.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "webhooks.0.events.*", "onMessageSend"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "webhooks.0.events.*", "onMessageSent"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceWebhookFilters(server, &sid, "onChannelAdded", "onMessageSend", "onMessageSent"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.0.events.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "webhooks.0.events.*", "onChannelAdded"),
+				),
+			},
+			{
+				// Twilio reports the filters in its own order, which is not a change.
+				PreConfig: func() {
+					server.UpdateService(sid, func(cs *openapi.ChatV2Service) {
+						cs.WebhookFilters = &[]string{"onmessagesent", "onChannelAdded", "onMessageSend"}
+					})
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					server.UpdateService(sid, func(cs *openapi.ChatV2Service) {
+						cs.WebhookFilters = &[]string{"onMessageSend"}
+					})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  testAccCheckServiceWebhookFilters(server, &sid, "onChannelAdded", "onMessageSend", "onMessageSent"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChatService_clearWebhookEvents(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      testAccCheckServiceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfigEvents(server, "onMessageSent", "onMessageSend"),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &sid),
+					testAccCheckServiceWebhookFilters(server, &sid, "onMessageSend", "onMessageSent"),
+				),
+			},
+			{
+				Config: testAccServiceConfigEvents(server),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceWebhookFilters(server, &sid),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.0.events.#", "0"),
+				),
+			},
+			{
+				Config:   testAccServiceConfigEvents(server),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccServiceConfigEvents(server, "onMessageSent"),
+				Check:  testAccCheckServiceWebhookFilters(server, &sid, "onMessageSent"),
+			},
+			{
+				// A webhooks block without events clears them as well.
+				Config: testAccServiceConfigWebhooks(server, "https://example.com/pre"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceWebhookFilters(server, &sid),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.0.events.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatService_disappears(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var sid string
+	config := testAccServiceConfigBasic(server, "disappears")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      testAccCheckServiceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &sid),
+					func(*terraform.State) error {
+						server.DeleteService(sid)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  server.CheckExists(resourceName, &sid),
+			},
+		},
+	})
+}
+
+func TestAccChatService_partialBlocks(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	keepsDefaults := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr(resourceName, "roles.0.default_channel_role", chattest.Sid("RL", 5)),
+		resource.TestCheckResourceAttr(resourceName, "limits.0.user_channels", "250"),
+		resource.TestCheckResourceAttr(resourceName, "additional_settings.0.read_status_enabled", "true"),
+		resource.TestCheckResourceAttr(resourceName, "additional_settings.0.typing_indicator_timeout", "5"),
+		resource.TestCheckResourceAttr(resourceName, "additional_settings.0.consumption_report_interval", "10"),
+		resource.TestCheckResourceAttr(resourceName, "webhooks.0.method", "POST"),
+		resource.TestCheckResourceAttr(resourceName, "webhooks.0.post_hook_url", ""),
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      testAccCheckServiceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfigPartial(server, 50),
+				Check: resource.ComposeTestCheckFunc(
+					keepsDefaults,
+					resource.TestCheckResourceAttr(resourceName, "roles.0.default_service_role", chattest.Sid("RL", 2)),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.channel_members", "50"),
+					resource.TestCheckResourceAttr(resourceName, "additional_settings.0.reachability_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks.0.pre_hook_url", "https://example.com/pre"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.0.new_message.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccServiceConfigPartial(server, 60),
+				Check: resource.ComposeTestCheckFunc(
+					keepsDefaults,
+					resource.TestCheckResourceAttr(resourceName, "limits.0.channel_members", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatService_rollback(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      testAccCheckServiceDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceConfigRoles(server, "RL00000000000000000000000000000000"),
+				ExpectError: regexp.MustCompile("DefaultServiceRoleSid"),
+			},
+		},
+	})
+}
@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"sort"
 	"time"
 
-	tw "github.com/twilio/twilio-go"
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/go-cty/cty"
 	openapi "github.com/twilio/twilio-go/rest/chat/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,7 +19,7 @@ func applyNotificationsToParams(params *openapi.UpdateServiceParams, settings ma
 		params.NotificationsLogEnabled = &logEnabled
 	}
 
-	if templates, ok := settings["new_message"].([]interface{}); ok {
+	if templates, ok := settings["new_message"].([]interface{}); ok && len(templates) > 0 && templates[0] != nil {
 		settings := templates[0].(map[string]interface{})
 		if v, ok := settings["enabled"].(bool); ok {
 			params.SetNotificationsNewMessageEnabled(v)
@@ -32,7 +35,7 @@ func applyNotificationsToParams(params *openapi.UpdateServiceParams, settings ma
 		}
 	}
 
-	if templates, ok := settings["invited_to_channel"].([]interface{}); ok {
+	if templates, ok := settings["invited_to_channel"].([]interface{}); ok && len(templates) > 0 && templates[0] != nil {
 		settings := templates[0].(map[string]interface{})
 		if v, ok := settings["enabled"].(bool); ok {
 			params.SetNotificationsInvitedToChannelEnabled(v)
@@ -45,7 +48,7 @@ func applyNotificationsToParams(params *openapi.UpdateServiceParams, settings ma
 		}
 	}
 
-	if templates, ok := settings["added_to_channel"].([]interface{}); ok {
+	if templates, ok := settings["added_to_channel"].([]interface{}); ok && len(templates) > 0 && templates[0] != nil {
 		settings := templates[0].(map[string]interface{})
 		if v, ok := settings["enabled"].(bool); ok {
 			params.SetNotificationsAddedToChannelEnabled(v)
@@ -58,7 +61,7 @@ func applyNotificationsToParams(params *openapi.UpdateServiceParams, settings ma
 		}
 	}
 
-	if templates, ok := settings["removed_from_channel"].([]interface{}); ok {
+	if templates, ok := settings["removed_from_channel"].([]interface{}); ok && len(templates) > 0 && templates[0] != nil {
 		settings := templates[0].(map[string]interface{})
 		if v, ok := settings["enabled"].(bool); ok {
 			params.SetNotificationsRemovedFromChannelEnabled(v)
@@ -74,9 +77,51 @@ func applyNotificationsToParams(params *openapi.UpdateServiceParams, settings ma
 	return params
 }
 
-func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*tw.RestClient)
+// configuredSettings returns the settings of the block key that are set in the
+// configuration. The blocks are Optional and Computed, so the settings left out
+// keep their values in Twilio instead of being reset to their zero value.
+func configuredSettings(d *schema.ResourceData, key string) map[string]interface{} {
+	blocks, ok := d.Get(key).([]interface{})
+	if !ok || len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	return pruneSettings(blocks[0].(map[string]interface{}), config.GetAttr(key))
+}
+
+// pruneSettings drops the settings that are null in the first element of the
+// block configuration, including those of nested blocks.
+func pruneSettings(settings map[string]interface{}, block cty.Value) map[string]interface{} {
+	if block.IsNull() || !block.IsKnown() || !block.Type().IsListType() || block.LengthInt() == 0 {
+		return nil
+	}
+	config := block.Index(cty.NumberIntVal(0))
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
 
+	pruned := map[string]interface{}{}
+	for k, v := range settings {
+		if !config.Type().HasAttribute(k) || config.GetAttr(k).IsNull() {
+			continue
+		}
+		if nested, ok := v.([]interface{}); ok && config.GetAttr(k).Type().IsListType() {
+			if len(nested) > 0 && nested[0] != nil {
+				if s := pruneSettings(nested[0].(map[string]interface{}), config.GetAttr(k)); s != nil {
+					pruned[k] = []interface{}{s}
+				}
+			}
+			continue
+		}
+		pruned[k] = v
+	}
+	return pruned
+}
+
+func updateServiceParams(d *schema.ResourceData) *openapi.UpdateServiceParams {
 	params := &openapi.UpdateServiceParams{}
 
 	if d.HasChange("friendly_name") {
@@ -86,9 +131,7 @@ func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) d
 	}
 
 	if d.HasChange("roles") {
-		roles := d.Get("roles").([]interface{})
-		if len(roles) > 0 {
-			settings := roles[0].(map[string]interface{})
+		if settings := configuredSettings(d, "roles"); settings != nil {
 			if v, ok := settings["default_service_role"].(string); ok {
 				params.SetDefaultServiceRoleSid(v)
 			}
@@ -102,9 +145,7 @@ func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) d
 	}
 
 	if d.HasChange("limits") {
-		limits := d.Get("limits").([]interface{})
-		if len(limits) > 0 {
-			settings := limits[0].(map[string]interface{})
+		if settings := configuredSettings(d, "limits"); settings != nil {
 			if v, ok := settings["channel_members"].(int); ok {
 				params.SetLimitsChannelMembers(v)
 			}
@@ -115,10 +156,7 @@ func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) d
 	}
 
 	if d.HasChange("additional_settings") {
-		additionalSettings := d.Get("additional_settings").([]interface{})
-
-		if len(additionalSettings) > 0 {
-			settings := additionalSettings[0].(map[string]interface{})
+		if settings := configuredSettings(d, "additional_settings"); settings != nil {
 			if v, ok := settings["reachability_enabled"].(bool); ok {
 				params.SetReachabilityEnabled(v)
 			}
@@ -141,19 +179,21 @@ func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) d
 	}
 
 	if d.HasChange("webhooks") {
-		webhooks := d.Get("webhooks").([]interface{})
-
-		if len(webhooks) > 0 {
-			settings := webhooks[0].(map[string]interface{})
-
-			if v, ok := settings["metheventsod"].([]interface{}); ok {
-				watchEvents := []string{}
-				for _, e := range v {
-					watchEvents = append(watchEvents, e.(string))
-				}
-				params.SetWebhookFilters(watchEvents)
+		// Removing the events from the configuration clears the filters.
+		if v, ok := d.Get("webhooks.0.events").(*schema.Set); ok && (v.Len() > 0 || d.HasChange("webhooks.0.events")) {
+			watchEvents := []string{}
+			for _, e := range v.List() {
+				watchEvents = append(watchEvents, e.(string))
+			}
+			sort.Strings(watchEvents)
+			if len(watchEvents) == 0 {
+				// twilio-go leaves empty lists out of the request, an empty value clears the filters.
+				watchEvents = []string{""}
 			}
+			params.SetWebhookFilters(watchEvents)
+		}
 
+		if settings := configuredSettings(d, "webhooks"); settings != nil {
 			if v, ok := settings["method"].(string); ok {
 				params.SetWebhookMethod(v)
 			}
@@ -167,15 +207,22 @@ func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) d
 	}
 
 	if d.HasChange("notifications") {
-		if notifications, ok := d.Get("notifications").([]interface{}); ok && len(notifications) > 0 {
-			settings := notifications[0].(map[string]interface{})
+		if settings := configuredSettings(d, "notifications"); settings != nil {
 			params = applyNotificationsToParams(params, settings)
 		}
 	}
 
-	res, err := client.ChatV2.UpdateService(d.Id(), params)
+	return params
+}
+
+func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Services(ctx)
+
+	res, err := client.UpdateService(d.Id(), updateServiceParams(d))
 	if err != nil {
-		return diag.FromErr(err)
+		// Keep the previous state so that the next apply retries the rejected settings.
+		d.Partial(true)
+		return api.DiagFromErr(err, parameterPaths)
 	}
 
 	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+)
+
+func TestApplyNotificationsToParams(t *testing.T) {
+	template := func(settings map[string]interface{}) []interface{} {
+		return []interface{}{settings}
+	}
+
+	tests := map[string]struct {
+		settings map[string]interface{}
+		want     openapi.UpdateServiceParams
+	}{
+		"log only": {
+			settings: map[string]interface{}{"log_enabled": true},
+			want: openapi.UpdateServiceParams{
+				NotificationsLogEnabled: boolPtr(true),
+			},
+		},
+		"log without templates": {
+			settings: map[string]interface{}{
+				"log_enabled":          true,
+				"new_message":          []interface{}{},
+				"invited_to_channel":   []interface{}{},
+				"added_to_channel":     []interface{}{},
+				"removed_from_channel": []interface{}{},
+			},
+			want: openapi.UpdateServiceParams{
+				NotificationsLogEnabled: boolPtr(true),
+			},
+		},
+		"empty template blocks": {
+			settings: map[string]interface{}{
+				"new_message":          []interface{}{nil},
+				"invited_to_channel":   []interface{}{nil},
+				"added_to_channel":     []interface{}{nil},
+				"removed_from_channel": []interface{}{nil},
+			},
+			want: openapi.UpdateServiceParams{},
+		},
+		"new message": {
+			settings: map[string]interface{}{
+				"log_enabled": false,
+				"new_message": template(map[string]interface{}{
+					"enabled":             true,
+					"template":            "${USER}: ${MESSAGE}",
+					"sound":               "default",
+					"badge_count_enabled": true,
+				}),
+			},
+			want: openapi.UpdateServiceParams{
+				NotificationsLogEnabled:                  boolPtr(false),
+				NotificationsNewMessageEnabled:           boolPtr(true),
+				NotificationsNewMessageTemplate:          stringPtr("${USER}: ${MESSAGE}"),
+				NotificationsNewMessageSound:             stringPtr("default"),
+				NotificationsNewMessageBadgeCountEnabled: boolPtr(true),
+			},
+		},
+		"channel templates": {
+			settings: map[string]interface{}{
+				"invited_to_channel":   template(map[string]interface{}{"enabled": true, "template": "invited", "sound": "a"}),
+				"added_to_channel":     template(map[string]interface{}{"enabled": false, "template": "added", "sound": "b"}),
+				"removed_from_channel": template(map[string]interface{}{"enabled": true, "template": "removed", "sound": "c"}),
+			},
+			want: openapi.UpdateServiceParams{
+				NotificationsInvitedToChannelEnabled:    boolPtr(true),
+				NotificationsInvitedToChannelTemplate:   stringPtr("invited"),
+				NotificationsInvitedToChannelSound:      stringPtr("a"),
+				NotificationsAddedToChannelEnabled:      boolPtr(false),
+				NotificationsAddedToChannelTemplate:     stringPtr("added"),
+				NotificationsAddedToChannelSound:        stringPtr("b"),
+				NotificationsRemovedFromChannelEnabled:  boolPtr(true),
+				NotificationsRemovedFromChannelTemplate: stringPtr("removed"),
+				NotificationsRemovedFromChannelSound:    stringPtr("c"),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := applyNotificationsToParams(&openapi.UpdateServiceParams{}, tt.settings)
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("applyNotificationsToParams() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+// testUpdateServiceParams returns the parameters that updateServiceParams
+// sends when a service configured with raw is created.
+func testUpdateServiceParams(t *testing.T, raw map[string]interface{}) openapi.UpdateServiceParams {
+	t.Helper()
+
+	var params *openapi.UpdateServiceParams
+	r := &schema.Resource{
+		Schema: ResourceCredentialService().Schema,
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			params = updateServiceParams(d)
+			d.SetId("IS00000000000000000000000000000001")
+			return nil
+		},
+	}
+
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RawConfig, err = ctyjson.Unmarshal(body, r.CoreConfigSchema().ImpliedType()); err != nil {
+		t.Fatal(err)
+	}
+	if _, diags := r.Apply(context.Background(), nil, diff, nil); diags.HasError() {
+		t.Fatal(diags)
+	}
+	return *params
+}
+
+func TestUpdateServiceParams(t *testing.T) {
+	tests := map[string]struct {
+		config map[string]interface{}
+		want   openapi.UpdateServiceParams
+	}{
+		"partial blocks": {
+			config: map[string]interface{}{
+				"friendly_name":       "test",
+				"roles":               []interface{}{map[string]interface{}{"default_service_role": "RL00000000000000000000000000000001"}},
+				"limits":              []interface{}{map[string]interface{}{"channel_members": 50}},
+				"additional_settings": []interface{}{map[string]interface{}{"reachability_enabled": true}},
+				"webhooks":            []interface{}{map[string]interface{}{"pre_hook_url": "https://example.com/pre"}},
+			},
+			want: openapi.UpdateServiceParams{
+				FriendlyName:          stringPtr("test"),
+				DefaultServiceRoleSid: stringPtr("RL00000000000000000000000000000001"),
+				LimitsChannelMembers:  intPtr(50),
+				ReachabilityEnabled:   boolPtr(true),
+				PreWebhookUrl:         stringPtr("https://example.com/pre"),
+			},
+		},
+		"notifications without templates": {
+			config: map[string]interface{}{
+				"friendly_name": "test",
+				"notifications": []interface{}{map[string]interface{}{"log_enabled": true}},
+			},
+			want: openapi.UpdateServiceParams{
+				FriendlyName:            stringPtr("test"),
+				NotificationsLogEnabled: boolPtr(true),
+			},
+		},
+		"partial template": {
+			config: map[string]interface{}{
+				"friendly_name": "test",
+				"notifications": []interface{}{map[string]interface{}{
+					"new_message": []interface{}{map[string]interface{}{"enabled": true}},
+				}},
+			},
+			want: openapi.UpdateServiceParams{
+				FriendlyName:                   stringPtr("test"),
+				NotificationsNewMessageEnabled: boolPtr(true),
+			},
+		},
+		"webhook events": {
+			config: map[string]interface{}{
+				"friendly_name": "test",
+				"webhooks": []interface{}{map[string]interface{}{
+					"events": []interface{}{"onMessageSent", "onChannelAdded"},
+				}},
+			},
+			want: openapi.UpdateServiceParams{
+				FriendlyName:   stringPtr("test"),
+				WebhookFilters: &[]string{"onChannelAdded", "onMessageSent"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := testUpdateServiceParams(t, tt.config); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("updateServiceParams() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
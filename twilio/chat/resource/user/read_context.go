@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Users(ctx)
+
+	var diags diag.Diagnostics
+
+	res, err := client.FetchUser(d.Get("service_sid").(string), d.Id())
+	if err != nil {
+		if api.IsNotFound(err) {
+			log.Printf("[WARN] Chat user %s not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	if err := d.Set("service_sid", res.ServiceSid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("identity", res.Identity); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("friendly_name", res.FriendlyName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("role_sid", res.RoleSid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("attributes", res.Attributes); err != nil {
+		return diag.FromErr(err)
+	}
+	// Twilio reports no online status when reachability is disabled for the service.
+	if err := d.Set("is_online", res.IsOnline != nil && *res.IsOnline); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_notifiable", res.IsNotifiable != nil && *res.IsNotifiable); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("joined_channels_count", res.JoinedChannelsCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := api.SetRegion(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", res.Url); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_created", res.DateCreated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("date_updated", res.DateUpdated.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
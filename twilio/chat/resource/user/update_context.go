@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+
+	"terraform-provider-twilio/twilio/api"
+
+	openapi "github.com/twilio/twilio-go/rest/chat/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func updateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client).Users(ctx)
+
+	params := &openapi.UpdateUserParams{}
+
+	if d.HasChange("friendly_name") {
+		params.SetFriendlyName(d.Get("friendly_name").(string))
+	}
+	if d.HasChange("role_sid") {
+		params.SetRoleSid(d.Get("role_sid").(string))
+	}
+	if d.HasChange("attributes") {
+		params.SetAttributes(d.Get("attributes").(string))
+	}
+
+	_, err := client.UpdateUser(d.Get("service_sid").(string), d.Id(), params)
+	if err != nil {
+		return api.DiagFromErr(err, parameterPaths)
+	}
+
+	return readContext(ctx, d, m)
+}
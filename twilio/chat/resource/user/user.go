@@ -0,0 +1,91 @@
+package user
+
+import (
+	"time"
+
+	"terraform-provider-twilio/twilio/api"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// parameterPaths maps the parameters of the user API to the attributes they are set from.
+var parameterPaths = api.ParameterPaths{
+	"Identity":     cty.GetAttrPath("identity"),
+	"FriendlyName": cty.GetAttrPath("friendly_name"),
+	"RoleSid":      cty.GetAttrPath("role_sid"),
+	"Attributes":   cty.GetAttrPath("attributes"),
+}
+
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createContext,
+		ReadContext:   readContext,
+		UpdateContext: updateContext,
+		DeleteContext: deleteContext,
+		CustomizeDiff: api.CustomizeDiffRegion,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: api.ImportStateWithParents("service_sid"),
+		},
+		Schema: map[string]*schema.Schema{
+			"service_sid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_sid": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"attributes": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "{}",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: api.SuppressEquivalentJSON,
+			},
+			"is_online": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_notifiable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"joined_channels_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": api.RegionSchema(),
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package user_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-twilio/twilio/chat/chattest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const resourceName = "twilio_chat_user.test"
+
+func testAccCheckUserDefaultRole(server *chattest.Server, serviceSid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		svc, ok := server.Service(*serviceSid)
+		if !ok {
+			return fmt.Errorf("chat service %s does not exist", *serviceSid)
+		}
+		return resource.TestCheckResourceAttr(resourceName, "role_sid", *svc.DefaultServiceRoleSid)(s)
+	}
+}
+
+func testAccUserConfigBasic(server *chattest.Server) string {
+	return server.ProviderConfig() + `
+resource "twilio_chat_service" "test" {
+  friendly_name = "users"
+}
+
+resource "twilio_chat_user" "test" {
+  service_sid = twilio_chat_service.test.id
+  identity    = "support-bot"
+}
+`
+}
+
+func testAccUserConfigBot(server *chattest.Server, friendlyName string, attributes string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "twilio_chat_service" "test" {
+  friendly_name = "users"
+}
+
+resource "twilio_chat_role" "bot" {
+  service_sid   = twilio_chat_service.test.id
+  friendly_name = "bot"
+  type          = "deployment"
+  permissions   = ["joinChannel", "editOwnUserInfo"]
+}
+
+resource "twilio_chat_user" "test" {
+  service_sid   = twilio_chat_service.test.id
+  identity      = "support-bot"
+  friendly_name = %q
+  role_sid      = twilio_chat_role.bot.id
+  attributes    = %s
+}
+`, friendlyName, attributes)
+}
+
+func TestAccChatUser_basic(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, sid string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfigBasic(server),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &sid),
+					testAccCheckUserDefaultRole(server, &serviceSid),
+					resource.TestCheckResourceAttr(resourceName, "identity", "support-bot"),
+					resource.TestCheckResourceAttr(resourceName, "attributes", "{}"),
+					resource.TestCheckResourceAttr(resourceName, "is_online", "false"),
+					resource.TestCheckResourceAttr(resourceName, "joined_channels_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "region", "us1"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccUserConfigBot(server, "Support Bot", `jsonencode({ bot = true })`),
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &sid),
+					resource.TestCheckResourceAttrPair(resourceName, "role_sid", "twilio_chat_role.bot", "id"),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "Support Bot"),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"bot":true}`),
+				),
+			},
+			{
+				Config:   testAccUserConfigBot(server, "Support Bot", `"{ \"bot\": true }"`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return serviceSid + "/" + sid, nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccChatUser_disappears(t *testing.T) {
+	server := chattest.NewServer()
+	defer server.Close()
+
+	var serviceSid, sid string
+	config := testAccUserConfigBasic(server)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: chattest.ProviderFactories,
+		CheckDestroy:      server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					server.CheckExists(resourceName, &serviceSid, &sid),
+					func(*terraform.State) error {
+						server.DeleteUser(serviceSid, sid)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  server.CheckExists(resourceName, &serviceSid, &sid),
+			},
+		},
+	})
+}
@@ -3,25 +3,135 @@ package twilio
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/twilio/twilio-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"terraform-provider-twilio/twilio/api"
 	chat "terraform-provider-twilio/twilio/chat"
 )
 
-func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+// credentials returns the username and password requests are signed with.
+// An API key takes precedence over the account auth token.
+func credentials(d *schema.ResourceData) (string, string, error) {
 	accountSid := d.Get("account_sid").(string)
 	authToken := d.Get("auth_token").(string)
+	apiKey := d.Get("api_key").(string)
+	apiSecret := d.Get("api_secret").(string)
+
+	if accountSid == "" {
+		return "", "", fmt.Errorf("account_sid is required, set it in the provider block or with TWILIO_ACCOUNT_SID")
+	}
+
+	switch {
+	case apiKey != "" && apiSecret != "":
+		return apiKey, apiSecret, nil
+	case apiKey != "":
+		return "", "", fmt.Errorf("api_secret is required when api_key is set, set it in the provider block or with TWILIO_API_SECRET")
+	case apiSecret != "":
+		return "", "", fmt.Errorf("api_key is required when api_secret is set, set it in the provider block or with TWILIO_API_KEY")
+	case authToken != "":
+		return accountSid, authToken, nil
+	}
+
+	return "", "", fmt.Errorf("either auth_token or api_key and api_secret is required, set them in the provider block or with TWILIO_AUTH_TOKEN or TWILIO_API_KEY and TWILIO_API_SECRET")
+}
 
+// endpoints returns the base URL overrides of the endpoints block.
+func endpoints(d *schema.ResourceData) map[string]string {
+	overrides := map[string]string{}
+
+	if blocks := d.Get("endpoints").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		for product, endpoint := range blocks[0].(map[string]interface{}) {
+			if v, ok := endpoint.(string); ok && v != "" {
+				overrides[product] = v
+			}
+		}
+	}
+
+	return overrides
+}
+
+func endpointsSchema() *schema.Schema {
+	products := map[string]*schema.Schema{}
+	for _, product := range api.EndpointProducts {
+		products[product] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		}
+	}
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: products,
+		},
+		Optional: true,
+		MaxItems: 1,
+	}
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	if (accountSid == "") || (authToken == "") {
-		return nil, diag.FromErr(fmt.Errorf("Error: %s", "accountSid and authToken is required"))
+	accountSid := d.Get("account_sid").(string)
+
+	username, password, err := credentials(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	subaccountSid := d.Get("subaccount_sid").(string)
+	friendlyName := d.Get("subaccount_friendly_name").(string)
+	// The Chat URLs carry no account SID that could redirect an API key to a subaccount.
+	if (subaccountSid != "" || friendlyName != "") && username != accountSid {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "A subaccount cannot be targeted with an API key",
+			Detail:   "An API key always acts as the account it was created in. Create the key in the subaccount and set account_sid to the subaccount SID instead of subaccount_sid or subaccount_friendly_name.",
+		}}
+	}
+
+	config := api.Config{
+		AccountSid:            accountSid,
+		Username:              username,
+		Password:              password,
+		MaxRetries:            d.Get("max_retries").(int),
+		MaxRetryWait:          time.Duration(d.Get("max_retry_wait").(int)) * time.Second,
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		RequestsPerSecond:     d.Get("requests_per_second").(int),
+		Region:                d.Get("region").(string),
+		Edge:                  d.Get("edge").(string),
+		Endpoints:             endpoints(d),
+		InsecureSkipVerify:    d.Get("insecure_skip_verify").(bool),
 	}
 
-	client := twilio.NewRestClientWithParams(accountSid, authToken, twilio.RestClientParams{})
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if friendlyName != "" {
+		if subaccountSid, err = client.FindSubaccount(ctx, friendlyName); err != nil {
+			return nil, api.DiagFromErr(err, api.ParameterPaths{
+				"FriendlyName": cty.GetAttrPath("subaccount_friendly_name"),
+			})
+		}
+	}
+
+	if subaccountSid != "" {
+		// Twilio accepts the parent auth token for requests signed as one of its subaccounts.
+		config.Username = subaccountSid
+		config.AccountSid = subaccountSid
+		if client, err = api.NewClient(config); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
 
 	return client, diags
 }
@@ -40,9 +150,72 @@ func Provider() *schema.Provider {
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("TWILIO_AUTH_TOKEN", nil),
 			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TWILIO_API_KEY", nil),
+			},
+			"api_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("TWILIO_API_SECRET", nil),
+			},
+			"subaccount_sid": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("TWILIO_SUBACCOUNT_SID", nil),
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile("^AC[0-9a-fA-F]{32}$"), "must be an account SID"),
+				ConflictsWith: []string{"subaccount_friendly_name"},
+			},
+			"subaccount_friendly_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"subaccount_sid"},
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TWILIO_REGION", nil),
+			},
+			"edge": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TWILIO_EDGE", nil),
+			},
+			"endpoints": endpointsSchema(),
+			"insecure_skip_verify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_retry_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"requests_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 		ResourcesMap:         chat.ResourcesMap,
-		DataSourcesMap:       map[string]*schema.Resource{},
+		DataSourcesMap:       chat.DataSourcesMap,
 		ConfigureContextFunc: providerConfigure,
 	}
 }